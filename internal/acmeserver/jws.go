@@ -0,0 +1,172 @@
+package acmeserver
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"certimate/internal/domain"
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+var eabSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.HS256, jose.HS384, jose.HS512,
+}
+
+// 校验 ACME 请求的 JWS（RFC 8555 6.2）：随机数、请求地址及签名。
+//
+// 入参：
+//   - ctx: 上下文。
+//   - body: 请求体。
+//   - url: 请求的完整地址。
+//
+// 出参：
+//   - 校验通过的请求。
+//   - 错误。
+func (s *AcmeServerService) Verify(ctx context.Context, body []byte, url string) (*domain.AcmeServerRequest, error) {
+	jws, err := jose.ParseSigned(string(body), signatureAlgorithms)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "failed to parse jws: %v", err)
+	}
+
+	if len(jws.Signatures) != 1 {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "jws must have exactly one signature")
+	}
+	header := jws.Signatures[0].Protected
+
+	if !s.nonces.Use(header.Nonce) {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "badNonce", "invalid or reused nonce")
+	}
+
+	if headerUrl, _ := header.ExtraHeaders["url"].(string); headerUrl != url {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "url header %q does not match request url %q", headerUrl, url)
+	}
+
+	req := &domain.AcmeServerRequest{Url: url}
+
+	var key *jose.JSONWebKey
+	switch {
+	case header.JSONWebKey != nil && header.KeyID != "":
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "jwk and kid are mutually exclusive")
+
+	case header.JSONWebKey != nil:
+		key = header.JSONWebKey
+		if !key.Valid() || !key.IsPublic() {
+			return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "badPublicKey", "invalid jwk")
+		}
+
+		jwk, err := key.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		req.Jwk = string(jwk)
+
+		if req.Thumbprint, err = getThumbprint(key); err != nil {
+			return nil, err
+		}
+
+	case header.KeyID != "":
+		account, err := s.getAccountByKid(ctx, header.KeyID)
+		if err != nil {
+			return nil, err
+		}
+
+		key = &jose.JSONWebKey{}
+		if err := key.UnmarshalJSON([]byte(account.Key)); err != nil {
+			return nil, err
+		}
+		req.Account = account
+
+	default:
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "jwk or kid is required")
+	}
+
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "invalid jws signature")
+	}
+	req.Payload = payload
+
+	return req, nil
+}
+
+func (s *AcmeServerService) getAccountByKid(ctx context.Context, kid string) (*domain.AcmeServerAccount, error) {
+	id := kid[strings.LastIndex(kid, "/")+1:]
+
+	account, err := s.repo.GetAccountById(ctx, id)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "accountDoesNotExist", "account %s does not exist", kid)
+	}
+
+	if account.Status != domain.AcmeServerStatusValid {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "account %s is %s", kid, account.Status)
+	}
+
+	return account, nil
+}
+
+// 校验外部账户绑定（RFC 8555 7.3.4）：使用 EAB 密钥签名，载荷为账户公钥。
+func verifyExternalAccountBinding(eab any, url string, thumbprint string, setting *domain.AcmeServerSetting) error {
+	data, err := json.Marshal(eab)
+	if err != nil {
+		return err
+	}
+
+	jws, err := jose.ParseSigned(string(data), eabSignatureAlgorithms)
+	if err != nil || len(jws.Signatures) != 1 {
+		return domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid external account binding")
+	}
+	header := jws.Signatures[0].Protected
+
+	if header.KeyID == "" || !hmac.Equal([]byte(header.KeyID), []byte(setting.EabKid)) {
+		return domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "unknown external account binding kid")
+	}
+
+	if headerUrl, _ := header.ExtraHeaders["url"].(string); headerUrl != url {
+		return domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "external account binding url mismatch")
+	}
+
+	hmacKey, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(setting.EabHmacKey, "="))
+	if err != nil {
+		return errors.New("invalid eab hmac key in acme server settings")
+	}
+
+	payload, err := jws.Verify(hmacKey)
+	if err != nil {
+		return domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "invalid external account binding signature")
+	}
+
+	key := &jose.JSONWebKey{}
+	if err := key.UnmarshalJSON(payload); err != nil {
+		return domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid external account binding payload")
+	}
+
+	eabThumbprint, err := getThumbprint(key)
+	if err != nil || eabThumbprint != thumbprint {
+		return domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "external account binding key does not match account key")
+	}
+
+	return nil
+}
+
+func getThumbprint(key *jose.JSONWebKey) (string, error) {
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
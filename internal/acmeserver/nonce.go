@@ -0,0 +1,64 @@
+package acmeserver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pocketbase/pocketbase/tools/security"
+)
+
+const (
+	nonceLength   = 32
+	nonceValidity = time.Hour
+	// 最多保存的随机数数量，newNonce 无需认证，超出时淘汰最早签发的随机数，避免内存无限增长。
+	nonceCapacity = 10000
+)
+
+// 防重放随机数，每个随机数只能使用一次。
+// 随机数按签发顺序保存在环形队列中，新随机数覆盖最早的一个，因此无需扫描清理过期的随机数，过期的在使用时拒绝。
+type nonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]time.Time
+	queue  []string
+	next   int
+}
+
+func newNonceStore() *nonceStore {
+	return newNonceStoreWithCapacity(nonceCapacity)
+}
+
+func newNonceStoreWithCapacity(capacity int) *nonceStore {
+	return &nonceStore{
+		nonces: make(map[string]time.Time, capacity),
+		queue:  make([]string, capacity),
+	}
+}
+
+func (s *nonceStore) New() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 淘汰最早签发的随机数，已使用的随机数不在 map 中，删除不会有影响
+	if oldest := s.queue[s.next]; oldest != "" {
+		delete(s.nonces, oldest)
+	}
+
+	nonce := security.RandomString(nonceLength)
+	s.nonces[nonce] = time.Now().Add(nonceValidity)
+	s.queue[s.next] = nonce
+	s.next = (s.next + 1) % len(s.queue)
+	return nonce
+}
+
+func (s *nonceStore) Use(nonce string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires, ok := s.nonces[nonce]
+	if !ok {
+		return false
+	}
+
+	delete(s.nonces, nonce)
+	return time.Now().Before(expires)
+}
@@ -0,0 +1,90 @@
+package acmeserver
+
+import (
+	"errors"
+	"sync"
+
+	"certimate/internal/domain"
+)
+
+const (
+	// 同时签发证书的订单数量，每个订单都需向上游 CA 完成一次完整申请，过多时容易触发限流。
+	fulfilWorkers = 4
+	// 等待签发的订单数量上限，超出时拒绝提交 CSR，客户端可稍后重试。
+	fulfilQueueSize = 100
+)
+
+var errFulfilQueueFull = errors.New("too many orders are being processed")
+
+// 订单签发队列，由固定数量的协程依次签发提交了 CSR 的订单。
+// 同一订单在签发结束前只会入队一次，重复提交或服务重启后重新入队均不会重复签发。
+type fulfilQueue struct {
+	mu       sync.Mutex
+	reserved map[string]struct{}
+	capacity int
+
+	orders  chan *domain.AcmeServerOrder
+	workers int
+	start   sync.Once
+	handle  func(order *domain.AcmeServerOrder)
+}
+
+func newFulfilQueue(workers, size int, handle func(order *domain.AcmeServerOrder)) *fulfilQueue {
+	return &fulfilQueue{
+		reserved: make(map[string]struct{}),
+		capacity: workers + size,
+		orders:   make(chan *domain.AcmeServerOrder, size),
+		workers:  workers,
+		handle:   handle,
+	}
+}
+
+// 为订单预留队列位置，预留成功后需调用 push 入队或调用 release 释放。
+//
+// 入参：
+//   - id: 订单 ID。
+//
+// 出参：
+//   - 是否预留成功，订单已在队列中时返回 false。
+//   - 错误，队列已满时返回 errFulfilQueueFull。
+func (q *fulfilQueue) reserve(id string) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.reserved[id]; ok {
+		return false, nil
+	}
+
+	// 预留数量包含正在签发的订单，因此入队时通道不会已满
+	if len(q.reserved) >= q.capacity {
+		return false, errFulfilQueueFull
+	}
+
+	q.reserved[id] = struct{}{}
+	return true, nil
+}
+
+func (q *fulfilQueue) release(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.reserved, id)
+}
+
+// 将已预留位置的订单入队，签发结束后自动释放。
+func (q *fulfilQueue) push(order *domain.AcmeServerOrder) {
+	q.start.Do(func() {
+		for i := 0; i < q.workers; i++ {
+			go q.work()
+		}
+	})
+
+	q.orders <- order
+}
+
+func (q *fulfilQueue) work() {
+	for order := range q.orders {
+		q.handle(order)
+		q.release(order.Id)
+	}
+}
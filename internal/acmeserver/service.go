@@ -0,0 +1,516 @@
+package acmeserver
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"certimate/internal/applicant"
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+	"certimate/internal/utils/app"
+)
+
+const orderValidity = time.Hour * 24 * 7
+
+type AcmeServerRepository interface {
+	GetAccountById(ctx context.Context, id string) (*domain.AcmeServerAccount, error)
+	GetAccountByThumbprint(ctx context.Context, thumbprint string) (*domain.AcmeServerAccount, error)
+	SaveAccount(ctx context.Context, account *domain.AcmeServerAccount) error
+	GetOrderById(ctx context.Context, id string) (*domain.AcmeServerOrder, error)
+	ListOrdersByAccount(ctx context.Context, accountId string) ([]*domain.AcmeServerOrder, error)
+	ListOrdersByStatus(ctx context.Context, status string) ([]*domain.AcmeServerOrder, error)
+	SaveOrder(ctx context.Context, order *domain.AcmeServerOrder) error
+}
+
+type SettingRepository interface {
+	GetByName(ctx context.Context, name string) (*domain.Setting, error)
+}
+
+// 内置的 RFC 8555 ACME 服务。
+// 订单中的域名只需在允许列表中即视为已验证，证书由 Certimate 使用模板域名记录的申请配置向上游 CA 或内置 CA 申请，
+// 因此内网主机无需持有 DNS 服务商的授权。
+type AcmeServerService struct {
+	repo        AcmeServerRepository
+	settingRepo SettingRepository
+	nonces      *nonceStore
+	queue       *fulfilQueue
+}
+
+func NewAcmeServerService(repo AcmeServerRepository, settingRepo SettingRepository) *AcmeServerService {
+	s := &AcmeServerService{
+		repo:        repo,
+		settingRepo: settingRepo,
+		nonces:      newNonceStore(),
+	}
+	s.queue = newFulfilQueue(fulfilWorkers, fulfilQueueSize, s.fulfil)
+
+	return s
+}
+
+func (s *AcmeServerService) GetSetting(ctx context.Context) (*domain.AcmeServerSetting, error) {
+	setting := &domain.AcmeServerSetting{}
+
+	record, err := s.settingRepo.GetByName(ctx, "acme-server")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return setting, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(record.Content), setting); err != nil {
+		return nil, fmt.Errorf("failed to parse acme server settings: %w", err)
+	}
+
+	return setting, nil
+}
+
+func (s *AcmeServerService) NewNonce() string {
+	return s.nonces.New()
+}
+
+// 注册账户，账户已存在时返回已有账户。
+//
+// 出参：
+//   - 账户。
+//   - 是否为新注册的账户。
+//   - 错误。
+func (s *AcmeServerService) NewAccount(ctx context.Context, req *domain.AcmeServerRequest) (*domain.AcmeServerAccount, bool, error) {
+	if req.Jwk == "" {
+		return nil, false, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "newAccount must be signed with jwk")
+	}
+
+	payload := &domain.AcmeServerNewAccountReq{}
+	if err := json.Unmarshal(req.Payload, payload); err != nil {
+		return nil, false, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid payload: %v", err)
+	}
+
+	account, err := s.repo.GetAccountByThumbprint(ctx, req.Thumbprint)
+	if err == nil {
+		if account.Status != domain.AcmeServerStatusValid {
+			return nil, false, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "account is %s", account.Status)
+		}
+		return account, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	if payload.OnlyReturnExisting {
+		return nil, false, domain.NewAcmeServerProblem(http.StatusBadRequest, "accountDoesNotExist", "account does not exist")
+	}
+
+	setting, err := s.GetSetting(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !setting.HasExternalAccountBinding() {
+		return nil, false, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "external account binding is not configured")
+	}
+
+	if payload.ExternalAccountBinding == nil {
+		return nil, false, domain.NewAcmeServerProblem(http.StatusBadRequest, "externalAccountRequired", "external account binding is required")
+	}
+
+	if err := verifyExternalAccountBinding(payload.ExternalAccountBinding, req.Url, req.Thumbprint, setting); err != nil {
+		return nil, false, err
+	}
+
+	account = &domain.AcmeServerAccount{
+		Status:     domain.AcmeServerStatusValid,
+		Key:        req.Jwk,
+		Thumbprint: req.Thumbprint,
+		Contact:    payload.Contact,
+	}
+	if err := s.repo.SaveAccount(ctx, account); err != nil {
+		return nil, false, err
+	}
+
+	return account, true, nil
+}
+
+// 查询或更新账户，载荷为空时仅查询。
+func (s *AcmeServerService) UpdateAccount(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerAccount, error) {
+	account, err := getRequestAccount(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Id != id {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "account mismatch")
+	}
+
+	if len(req.Payload) == 0 {
+		return account, nil
+	}
+
+	payload := &domain.AcmeServerUpdateAccountReq{}
+	if err := json.Unmarshal(req.Payload, payload); err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid payload: %v", err)
+	}
+
+	if payload.Contact != nil {
+		account.Contact = payload.Contact
+	}
+
+	switch payload.Status {
+	case "":
+	case domain.AcmeServerStatusDeactivated:
+		account.Status = domain.AcmeServerStatusDeactivated
+	default:
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "unsupported account status %s", payload.Status)
+	}
+
+	if err := s.repo.SaveAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// 创建订单。订单中的域名均在允许列表中时，授权直接视为有效，订单可以立即提交 CSR。
+func (s *AcmeServerService) NewOrder(ctx context.Context, req *domain.AcmeServerRequest) (*domain.AcmeServerOrder, error) {
+	account, err := getRequestAccount(req)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &domain.AcmeServerNewOrderReq{}
+	if err := json.Unmarshal(req.Payload, payload); err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid payload: %v", err)
+	}
+
+	if len(payload.Identifiers) == 0 {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "identifiers is empty")
+	}
+
+	setting, err := s.GetSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]domain.AcmeServerIdentifier, 0, len(payload.Identifiers))
+	for _, identifier := range payload.Identifiers {
+		value := strings.ToLower(strings.TrimSpace(identifier.Value))
+
+		switch identifier.Type {
+		case "dns":
+		case "ip":
+			if net.ParseIP(value) == nil {
+				return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "rejectedIdentifier", "invalid ip identifier %s", identifier.Value)
+			}
+		default:
+			return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "unsupportedIdentifier", "unsupported identifier type %s", identifier.Type)
+		}
+
+		if !isIdentifierAllowed(value, setting.AllowedDomains) {
+			return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "rejectedIdentifier", "identifier %s is not allowed", identifier.Value)
+		}
+
+		identifiers = append(identifiers, domain.AcmeServerIdentifier{Type: identifier.Type, Value: value})
+	}
+
+	order := &domain.AcmeServerOrder{
+		Account:     account.Id,
+		Status:      domain.AcmeServerStatusReady,
+		Identifiers: identifiers,
+		Expires:     time.Now().Add(orderValidity),
+	}
+	if err := s.repo.SaveOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *AcmeServerService) GetOrder(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, error) {
+	account, err := getRequestAccount(req)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.repo.GetOrderById(ctx, id)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "order %s not found", id)
+	}
+
+	if order.Account != account.Id {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "order %s does not belong to the account", id)
+	}
+
+	if order.Status != domain.AcmeServerStatusValid && order.Status != domain.AcmeServerStatusInvalid && time.Now().After(order.Expires) {
+		order.Status = domain.AcmeServerStatusInvalid
+	}
+
+	return order, nil
+}
+
+// 查询账户下未过期的订单。
+func (s *AcmeServerService) ListOrders(ctx context.Context, req *domain.AcmeServerRequest, accountId string) ([]*domain.AcmeServerOrder, error) {
+	account, err := getRequestAccount(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Id != accountId {
+		return nil, domain.NewAcmeServerProblem(http.StatusUnauthorized, "unauthorized", "account mismatch")
+	}
+
+	return s.repo.ListOrdersByAccount(ctx, accountId)
+}
+
+// 获取授权，授权 ID 由订单 ID 及标识符序号组成。
+//
+// 出参：
+//   - 授权所属的订单。
+//   - 标识符序号。
+//   - 错误。
+func (s *AcmeServerService) GetAuthorization(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, int, error) {
+	orderId, idxStr, ok := strings.Cut(id, "-")
+	if !ok {
+		return nil, 0, domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "authorization %s not found", id)
+	}
+
+	order, err := s.GetOrder(ctx, req, orderId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	idx, err := strconv.Atoi(idxStr)
+	if err != nil || idx < 0 || idx >= len(order.Identifiers) {
+		return nil, 0, domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "authorization %s not found", id)
+	}
+
+	return order, idx, nil
+}
+
+// 提交 CSR。证书在后台申请，客户端需轮询订单状态。
+// 订单已在签发中时重复提交不做处理，直接返回订单。
+func (s *AcmeServerService) Finalize(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, error) {
+	order, err := s.GetOrder(ctx, req, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status == domain.AcmeServerStatusProcessing {
+		return order, nil
+	}
+
+	if order.Status != domain.AcmeServerStatusReady {
+		return nil, domain.NewAcmeServerProblem(http.StatusForbidden, "orderNotReady", "order is %s", order.Status)
+	}
+
+	payload := &domain.AcmeServerFinalizeReq{}
+	if err := json.Unmarshal(req.Payload, payload); err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "invalid payload: %v", err)
+	}
+
+	der, err := base64.RawURLEncoding.DecodeString(payload.Csr)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "badCSR", "invalid csr encoding")
+	}
+
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "badCSR", "failed to parse csr: %v", err)
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "badCSR", "invalid csr signature")
+	}
+
+	if err := checkCsrIdentifiers(csr, order.Identifiers); err != nil {
+		return nil, err
+	}
+
+	// 同时提交的请求只有一个可以入队，其余视为重复提交
+	reserved, err := s.queue.reserve(order.Id)
+	if err != nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusTooManyRequests, "rateLimited", "%v, please retry later", err)
+	}
+	if !reserved {
+		order.Status = domain.AcmeServerStatusProcessing
+		return order, nil
+	}
+
+	order.Status = domain.AcmeServerStatusProcessing
+	order.Csr = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+	if err := s.repo.SaveOrder(ctx, order); err != nil {
+		s.queue.release(order.Id)
+		return nil, err
+	}
+
+	s.queue.push(order)
+
+	return order, nil
+}
+
+// 服务启动时重新签发重启前仍在签发中的订单，已过期或无法入队的订单标记为无效。
+func (s *AcmeServerService) ResumeProcessing(ctx context.Context) error {
+	orders, err := s.repo.ListOrdersByStatus(ctx, domain.AcmeServerStatusProcessing)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if time.Now().After(order.Expires) {
+			s.fail(ctx, order, errors.New("order expired before the certificate was issued"))
+			continue
+		}
+
+		reserved, err := s.queue.reserve(order.Id)
+		if err != nil {
+			s.fail(ctx, order, err)
+			continue
+		}
+		if reserved {
+			s.queue.push(order)
+		}
+	}
+
+	return nil
+}
+
+func (s *AcmeServerService) GetCertificate(ctx context.Context, req *domain.AcmeServerRequest, id string) (string, error) {
+	order, err := s.GetOrder(ctx, req, id)
+	if err != nil {
+		return "", err
+	}
+
+	if order.Status != domain.AcmeServerStatusValid {
+		return "", domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "certificate is not ready")
+	}
+
+	return order.Certificate, nil
+}
+
+// 使用模板域名记录的申请配置为订单签发证书。
+func (s *AcmeServerService) fulfil(order *domain.AcmeServerOrder) {
+	ctx := context.Background()
+
+	certificate, err := s.issue(ctx, order)
+	if err != nil {
+		s.fail(ctx, order, err)
+		return
+	}
+
+	order.Status = domain.AcmeServerStatusValid
+	order.Certificate = certificate
+	if err := s.repo.SaveOrder(ctx, order); err != nil {
+		app.GetApp().Logger().Error("保存 ACME 订单失败", "order", order.Id, "err", err)
+	}
+}
+
+func (s *AcmeServerService) fail(ctx context.Context, order *domain.AcmeServerOrder, err error) {
+	app.GetApp().Logger().Error("ACME 服务签发证书失败", "order", order.Id, "err", err)
+
+	order.Status = domain.AcmeServerStatusInvalid
+	order.Error = domain.NewAcmeServerProblem(http.StatusInternalServerError, "serverInternal", "failed to issue certificate: %v", err)
+	if err := s.repo.SaveOrder(ctx, order); err != nil {
+		app.GetApp().Logger().Error("保存 ACME 订单失败", "order", order.Id, "err", err)
+	}
+}
+
+func (s *AcmeServerService) issue(ctx context.Context, order *domain.AcmeServerOrder) (string, error) {
+	setting, err := s.GetSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	record, err := app.GetApp().Dao().FindRecordById("domains", setting.DomainId)
+	if err != nil {
+		return "", fmt.Errorf("template domain not found: %w", err)
+	}
+
+	applyConfig := domain.ApplyConfig{}
+	if err := record.UnmarshalJSONField("applyConfig", &applyConfig); err != nil {
+		return "", err
+	}
+
+	applicant, err := applicant.GetForCSR(applyConfig, order.Csr, deployer.NewNilLogger())
+	if err != nil {
+		return "", err
+	}
+
+	certificate, err := applicant.Apply()
+	if err != nil {
+		return "", err
+	}
+
+	return certificate.Certificate, nil
+}
+
+func getRequestAccount(req *domain.AcmeServerRequest) (*domain.AcmeServerAccount, error) {
+	if req.Account == nil {
+		return nil, domain.NewAcmeServerProblem(http.StatusBadRequest, "malformed", "request must be signed with kid")
+	}
+
+	return req.Account, nil
+}
+
+// CSR 中的域名及 IP 地址必须与订单中的标识符完全一致。
+func checkCsrIdentifiers(csr *x509.CertificateRequest, identifiers []domain.AcmeServerIdentifier) error {
+	names := make([]string, 0, len(csr.DNSNames)+len(csr.IPAddresses))
+	for _, name := range csr.DNSNames {
+		names = append(names, strings.ToLower(name))
+	}
+	for _, ip := range csr.IPAddresses {
+		names = append(names, ip.String())
+	}
+	if cn := strings.ToLower(csr.Subject.CommonName); cn != "" && !slices.Contains(names, cn) {
+		names = append(names, cn)
+	}
+
+	values := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		if identifier.Type == "ip" {
+			values = append(values, net.ParseIP(identifier.Value).String())
+		} else {
+			values = append(values, identifier.Value)
+		}
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+	slices.Sort(values)
+	values = slices.Compact(values)
+	if !slices.Equal(names, values) {
+		return domain.NewAcmeServerProblem(http.StatusBadRequest, "badCSR", "csr identifiers %v do not match order identifiers %v", names, values)
+	}
+
+	return nil
+}
+
+// 判断标识符是否在允许列表中。"*.example.com" 匹配 example.com 下任意层级的子域名（含通配符域名），但不匹配 example.com 本身。
+func isIdentifierAllowed(value string, allowed []string) bool {
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+
+		if value == pattern {
+			return true
+		}
+
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok && strings.HasSuffix(value, "."+suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,259 @@
+package acmeserver
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"certimate/internal/domain"
+)
+
+func TestIsIdentifierAllowed(t *testing.T) {
+	allowed := []string{"*.example.com", "internal.test", " "}
+
+	cases := []struct {
+		value string
+		want  bool
+	}{
+		{"internal.test", true},
+		{"a.example.com", true},
+		{"a.b.example.com", true},
+		{"*.example.com", true},
+		{"example.com", false},
+		{"badexample.com", false},
+		{"a.internal.test", false},
+	}
+
+	for _, c := range cases {
+		if got := isIdentifierAllowed(c.value, allowed); got != c.want {
+			t.Errorf("isIdentifierAllowed(%q) = %v, want %v", c.value, got, c.want)
+		}
+	}
+}
+
+func TestCheckCsrIdentifiers(t *testing.T) {
+	identifiers := []domain.AcmeServerIdentifier{
+		{Type: "dns", Value: "a.example.com"},
+		{Type: "ip", Value: "10.0.0.1"},
+	}
+
+	csr := &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "A.example.com"},
+		DNSNames:    []string{"a.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}
+	if err := checkCsrIdentifiers(csr, identifiers); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	csr.DNSNames = append(csr.DNSNames, "b.example.com")
+	if err := checkCsrIdentifiers(csr, identifiers); err == nil {
+		t.Error("expected error for extra identifier in csr")
+	}
+}
+
+type testAcmeServerRepository struct {
+	accounts []*domain.AcmeServerAccount
+	orders   []*domain.AcmeServerOrder
+}
+
+func (r *testAcmeServerRepository) GetAccountById(ctx context.Context, id string) (*domain.AcmeServerAccount, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *testAcmeServerRepository) GetAccountByThumbprint(ctx context.Context, thumbprint string) (*domain.AcmeServerAccount, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *testAcmeServerRepository) SaveAccount(ctx context.Context, account *domain.AcmeServerAccount) error {
+	r.accounts = append(r.accounts, account)
+	return nil
+}
+
+func (r *testAcmeServerRepository) GetOrderById(ctx context.Context, id string) (*domain.AcmeServerOrder, error) {
+	for _, order := range r.orders {
+		if order.Id == id {
+			rs := *order
+			return &rs, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *testAcmeServerRepository) ListOrdersByAccount(ctx context.Context, accountId string) ([]*domain.AcmeServerOrder, error) {
+	rs := make([]*domain.AcmeServerOrder, 0)
+	for _, order := range r.orders {
+		if order.Account == accountId {
+			rs = append(rs, order)
+		}
+	}
+
+	return rs, nil
+}
+
+func (r *testAcmeServerRepository) ListOrdersByStatus(ctx context.Context, status string) ([]*domain.AcmeServerOrder, error) {
+	rs := make([]*domain.AcmeServerOrder, 0)
+	for _, order := range r.orders {
+		if order.Status == status {
+			rs = append(rs, order)
+		}
+	}
+
+	return rs, nil
+}
+
+func (r *testAcmeServerRepository) SaveOrder(ctx context.Context, order *domain.AcmeServerOrder) error {
+	return nil
+}
+
+type testSettingRepository struct {
+	content string
+}
+
+func (r *testSettingRepository) GetByName(ctx context.Context, name string) (*domain.Setting, error) {
+	return &domain.Setting{Name: name, Content: r.content}, nil
+}
+
+func TestNewAccountRequiresExternalAccountBinding(t *testing.T) {
+	cases := []struct {
+		name     string
+		setting  string
+		wantType string
+	}{
+		{"eab not configured", `{"enabled":true,"allowedDomains":["*.example.com"]}`, "urn:ietf:params:acme:error:unauthorized"},
+		{"eab not provided", `{"enabled":true,"allowedDomains":["*.example.com"],"eabKid":"kid","eabHmacKey":"a2V5"}`, "urn:ietf:params:acme:error:externalAccountRequired"},
+	}
+
+	for _, c := range cases {
+		repo := &testAcmeServerRepository{}
+		service := NewAcmeServerService(repo, &testSettingRepository{content: c.setting})
+
+		_, _, err := service.NewAccount(context.Background(), &domain.AcmeServerRequest{
+			Url:        "https://example.com/acme/new-account",
+			Payload:    []byte(`{"termsOfServiceAgreed":true}`),
+			Jwk:        `{"kty":"EC"}`,
+			Thumbprint: "thumbprint",
+		})
+		if err == nil {
+			t.Errorf("%s: expected error", c.name)
+			continue
+		}
+		if got := domain.ToAcmeServerProblem(err).Type; got != c.wantType {
+			t.Errorf("%s: problem type = %q, want %q", c.name, got, c.wantType)
+		}
+		if len(repo.accounts) != 0 {
+			t.Errorf("%s: account should not be saved", c.name)
+		}
+	}
+}
+
+func TestListOrders(t *testing.T) {
+	repo := &testAcmeServerRepository{orders: []*domain.AcmeServerOrder{
+		{Id: "order-1", Account: "account-1"},
+		{Id: "order-2", Account: "account-2"},
+	}}
+	service := NewAcmeServerService(repo, &testSettingRepository{})
+	req := &domain.AcmeServerRequest{Account: &domain.AcmeServerAccount{Id: "account-1"}}
+
+	orders, err := service.ListOrders(context.Background(), req, "account-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(orders) != 1 || orders[0].Id != "order-1" {
+		t.Errorf("unexpected orders: %v", orders)
+	}
+
+	if _, err := service.ListOrders(context.Background(), req, "account-2"); err == nil {
+		t.Error("expected error for other account")
+	}
+}
+
+func TestFinalizeProcessingOrder(t *testing.T) {
+	repo := &testAcmeServerRepository{orders: []*domain.AcmeServerOrder{
+		{Id: "order-1", Account: "account-1", Status: domain.AcmeServerStatusProcessing, Expires: time.Now().Add(time.Hour)},
+	}}
+	service := NewAcmeServerService(repo, &testSettingRepository{})
+	req := &domain.AcmeServerRequest{Account: &domain.AcmeServerAccount{Id: "account-1"}, Payload: []byte(`{"csr":"invalid"}`)}
+
+	// 签发中的订单重复提交时不校验 CSR，也不重新入队
+	order, err := service.Finalize(context.Background(), req, "order-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.Status != domain.AcmeServerStatusProcessing {
+		t.Errorf("unexpected status: %s", order.Status)
+	}
+	if len(service.queue.reserved) != 0 {
+		t.Errorf("order should not be queued again")
+	}
+}
+
+func TestFulfilQueue(t *testing.T) {
+	done := make(chan string)
+	queue := newFulfilQueue(1, 1, func(order *domain.AcmeServerOrder) {
+		done <- order.Id
+	})
+
+	if ok, err := queue.reserve("order-1"); !ok || err != nil {
+		t.Fatalf("expected reserved, got %v, %v", ok, err)
+	}
+	if ok, err := queue.reserve("order-1"); ok || err != nil {
+		t.Errorf("expected duplicate to be ignored, got %v, %v", ok, err)
+	}
+	if ok, err := queue.reserve("order-2"); !ok || err != nil {
+		t.Fatalf("expected reserved, got %v, %v", ok, err)
+	}
+	if _, err := queue.reserve("order-3"); !errors.Is(err, errFulfilQueueFull) {
+		t.Errorf("expected queue full, got %v", err)
+	}
+
+	queue.push(&domain.AcmeServerOrder{Id: "order-1"})
+	queue.push(&domain.AcmeServerOrder{Id: "order-2"})
+	if id := <-done; id != "order-1" {
+		t.Errorf("unexpected order: %s", id)
+	}
+	if id := <-done; id != "order-2" {
+		t.Errorf("unexpected order: %s", id)
+	}
+
+	// 签发结束后释放，可以再次预留
+	for i := 0; i < 100; i++ {
+		if ok, err := queue.reserve("order-3"); ok && err == nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("expected queue to be released after fulfil")
+}
+
+func TestNonceStore(t *testing.T) {
+	store := newNonceStoreWithCapacity(2)
+
+	first := store.New()
+	second := store.New()
+	if !store.Use(second) {
+		t.Error("expected nonce to be valid")
+	}
+	if store.Use(second) {
+		t.Error("expected nonce to be used only once")
+	}
+
+	// 超出容量时淘汰最早签发的随机数
+	third := store.New()
+	fourth := store.New()
+	if store.Use(first) {
+		t.Error("expected oldest nonce to be evicted")
+	}
+	if !store.Use(third) || !store.Use(fourth) {
+		t.Error("expected recent nonces to be valid")
+	}
+	if len(store.nonces) != 0 {
+		t.Errorf("unexpected stored nonces: %d", len(store.nonces))
+	}
+}
@@ -0,0 +1,199 @@
+package applicant
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/mail"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/registration"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
+)
+
+type AcmeAccountService struct {
+	repo AcmeAccountRepository
+}
+
+func NewAcmeAccountService() *AcmeAccountService {
+	return &AcmeAccountService{
+		repo: getAcmeAccountRepository(),
+	}
+}
+
+func (s *AcmeAccountService) List(ctx context.Context) ([]*domain.AcmeAccountResp, error) {
+	accounts, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*domain.AcmeAccountResp, 0, len(accounts))
+	for _, account := range accounts {
+		item := &domain.AcmeAccountResp{
+			Id:      account.Id,
+			Ca:      account.Ca,
+			Email:   account.Email,
+			Status:  account.Status,
+			Created: account.Created,
+			Updated: account.Updated,
+		}
+		if account.Resource != nil {
+			item.Uri = account.Resource.URI
+		}
+		rs = append(rs, item)
+	}
+
+	return rs, nil
+}
+
+// 轮换账户私钥，轮换成功后使用新私钥签名后续请求。
+func (s *AcmeAccountService) KeyChange(ctx context.Context, req *domain.AcmeAccountKeyChangeReq) error {
+	account, err := s.getValidAccount(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+
+	config, user, err := newAccountClientConfig(account)
+	if err != nil {
+		return err
+	}
+
+	oldKey, ok := user.GetPrivateKey().(*ecdsa.PrivateKey)
+	if !ok || oldKey == nil {
+		return errors.New("failed to parse acme account key")
+	}
+
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	newKeyPem, err := x509.ConvertECPrivateKeyToPEM(newKey)
+	if err != nil {
+		return err
+	}
+
+	if err := rolloverAccountKey(config.HTTPClient, config.CADirURL, account.Resource.URI, oldKey, newKey); err != nil {
+		return fmt.Errorf("failed to change account key: %w", err)
+	}
+
+	account.Key = newKeyPem
+	return s.repo.Update(ctx, account)
+}
+
+// 更新账户的联系邮箱。
+// 账户按 CA 及邮箱匹配，使用原邮箱的域名需同步修改申请配置中的邮箱，否则下次申请时将以原邮箱注册新账户。
+func (s *AcmeAccountService) UpdateContact(ctx context.Context, req *domain.AcmeAccountUpdateContactReq) error {
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		return fmt.Errorf("invalid email: %s", req.Email)
+	}
+
+	account, err := s.getValidAccount(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+
+	if existing, err := s.repo.GetByCAAndEmail(account.Ca, req.Email); err == nil && existing.Id != account.Id {
+		return fmt.Errorf("acme account %s already exists on %s", req.Email, account.Ca)
+	}
+
+	client, user, err := newAccountClient(account)
+	if err != nil {
+		return err
+	}
+
+	user.Email = req.Email
+	reg, err := client.Registration.UpdateRegistration(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	if err != nil {
+		return fmt.Errorf("failed to update account contact: %w", err)
+	}
+
+	account.Email = req.Email
+	account.Resource = reg
+	return s.repo.Update(ctx, account)
+}
+
+// 停用账户。停用后 CA 将拒绝该账户的所有请求，且无法恢复；下次申请时将以相同邮箱注册新账户。
+func (s *AcmeAccountService) Deactivate(ctx context.Context, req *domain.AcmeAccountDeactivateReq) error {
+	account, err := s.getValidAccount(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+
+	client, _, err := newAccountClient(account)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Registration.DeleteRegistration(); err != nil {
+		return fmt.Errorf("failed to deactivate account: %w", err)
+	}
+
+	account.Status = domain.AcmeAccountStatusDeactivated
+	account.Resource.Body.Status = acme.StatusDeactivated
+	return s.repo.Update(ctx, account)
+}
+
+func (s *AcmeAccountService) getValidAccount(ctx context.Context, id string) (*domain.AcmeAccount, error) {
+	account, err := s.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("acme account not found: %w", err)
+	}
+
+	if account.Status == domain.AcmeAccountStatusDeactivated {
+		return nil, errors.New("acme account has been deactivated")
+	}
+
+	if account.Resource == nil || account.Resource.URI == "" {
+		return nil, errors.New("acme account is not registered")
+	}
+
+	return account, nil
+}
+
+// 使用已注册的账户创建 ACME 客户端。
+func newAccountClient(account *domain.AcmeAccount) (*lego.Client, *ApplyUser, error) {
+	config, user, err := newAccountClientConfig(account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := lego.NewClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, user, nil
+}
+
+func newAccountClientConfig(account *domain.AcmeAccount) (*lego.Config, *ApplyUser, error) {
+	sslProvider, err := getSSLProviderConfigByCA(account.Ca)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caDirUrl, err := getSSLProviderUrl(sslProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := &ApplyUser{
+		Ca:           account.Ca,
+		Email:        account.Email,
+		Registration: account.Resource,
+		key:          account.Key,
+	}
+
+	config, err := newClientConfig(sslProvider, caDirUrl, user, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, user, nil
+}
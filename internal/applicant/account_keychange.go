@@ -0,0 +1,188 @@
+package applicant
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-jose/go-jose/v4"
+)
+
+const acmeErrorBadNonce = "urn:ietf:params:acme:error:badNonce"
+
+// 轮换 ACME 账户私钥（RFC 8555 7.3.5），lego 未提供该接口。
+// 内层 JWS 使用新私钥签名，外层 JWS 使用原私钥以账户地址作为 kid 签名。
+//
+// 入参：
+//   - httpClient: 访问 CA 使用的 HTTP 客户端。
+//   - caDirUrl: CA 的 ACME 目录地址。
+//   - accountUrl: 账户地址。
+//   - oldKey: 原私钥。
+//   - newKey: 新私钥。
+//
+// 出参：
+//   - 错误。
+func rolloverAccountKey(httpClient *http.Client, caDirUrl, accountUrl string, oldKey, newKey crypto.Signer) error {
+	dir, err := getAcmeDirectory(httpClient, caDirUrl)
+	if err != nil {
+		return err
+	}
+
+	if dir.KeyChangeURL == "" {
+		return errors.New("the ca does not support account key rollover")
+	}
+
+	inner, err := signKeyChange(dir.KeyChangeURL, accountUrl, oldKey, newKey)
+	if err != nil {
+		return err
+	}
+
+	nonces := &acmeNonceSource{httpClient: httpClient, url: dir.NewNonceURL}
+
+	// 随机数失效时重试一次
+	for retry := 0; ; retry++ {
+		err = postSigned(httpClient, nonces, dir.KeyChangeURL, accountUrl, oldKey, inner)
+
+		var problem *acme.ProblemDetails
+		if retry == 0 && errors.As(err, &problem) && problem.Type == acmeErrorBadNonce {
+			continue
+		}
+
+		return err
+	}
+}
+
+func signKeyChange(keyChangeUrl, accountUrl string, oldKey, newKey crypto.Signer) ([]byte, error) {
+	alg, err := getSignatureAlgorithm(newKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: newKey}, &jose.SignerOptions{
+		EmbedJWK:     true,
+		ExtraHeaders: map[jose.HeaderKey]any{"url": keyChangeUrl},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"account": accountUrl,
+		"oldKey":  jose.JSONWebKey{Key: oldKey.Public()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(jws.FullSerialize()), nil
+}
+
+func postSigned(httpClient *http.Client, nonces jose.NonceSource, url, accountUrl string, key crypto.Signer, payload []byte) error {
+	alg, err := getSignatureAlgorithm(key)
+	if err != nil {
+		return err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: jose.JSONWebKey{Key: key, KeyID: accountUrl}}, &jose.SignerOptions{
+		NonceSource:  nonces,
+		ExtraHeaders: map[jose.HeaderKey]any{"url": url},
+	})
+	if err != nil {
+		return err
+	}
+
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Post(url, "application/jose+json", bytes.NewBufferString(jws.FullSerialize()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		problem := &acme.ProblemDetails{}
+		if err := json.NewDecoder(resp.Body).Decode(problem); err != nil {
+			return fmt.Errorf("unexpected response status: %s", resp.Status)
+		}
+		problem.HTTPStatus = resp.StatusCode
+		problem.Method = http.MethodPost
+		problem.URL = url
+		return problem
+	}
+
+	return nil
+}
+
+func getAcmeDirectory(httpClient *http.Client, caDirUrl string) (*acme.Directory, error) {
+	resp, err := httpClient.Get(caDirUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get acme directory: %s", resp.Status)
+	}
+
+	dir := &acme.Directory{}
+	if err := json.NewDecoder(resp.Body).Decode(dir); err != nil {
+		return nil, err
+	}
+
+	return dir, nil
+}
+
+type acmeNonceSource struct {
+	httpClient *http.Client
+	url        string
+}
+
+func (s *acmeNonceSource) Nonce() (string, error) {
+	resp, err := s.httpClient.Head(s.url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	nonce := resp.Header.Get("Replay-Nonce")
+	if nonce == "" {
+		return "", errors.New("server did not respond with a nonce")
+	}
+
+	return nonce, nil
+}
+
+func getSignatureAlgorithm(key crypto.Signer) (jose.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jose.RS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported account key type: %T", key)
+}
@@ -0,0 +1,99 @@
+package applicant
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+func TestRolloverAccountKey(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	newKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	var server *httptest.Server
+	accountUrl := ""
+	badNonce := true
+	rolled := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/directory", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"newNonce":  server.URL + "/new-nonce",
+			"keyChange": server.URL + "/key-change",
+		})
+	})
+	mux.HandleFunc("/new-nonce", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", "nonce")
+	})
+	mux.HandleFunc("/key-change", func(w http.ResponseWriter, r *http.Request) {
+		// 首次请求返回随机数失效，验证会重试
+		if badNonce {
+			badNonce = false
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"type":"urn:ietf:params:acme:error:badNonce","detail":"bad nonce"}`))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		outer, err := jose.ParseSigned(string(body), []jose.SignatureAlgorithm{jose.ES256})
+		if err != nil {
+			t.Fatalf("failed to parse outer jws: %v", err)
+		}
+		if kid := outer.Signatures[0].Protected.KeyID; kid != accountUrl {
+			t.Errorf("unexpected outer kid: %s", kid)
+		}
+		innerPayload, err := outer.Verify(oldKey.Public())
+		if err != nil {
+			t.Fatalf("outer jws not signed by old key: %v", err)
+		}
+
+		inner, err := jose.ParseSigned(string(innerPayload), []jose.SignatureAlgorithm{jose.ES256})
+		if err != nil {
+			t.Fatalf("failed to parse inner jws: %v", err)
+		}
+		if url := inner.Signatures[0].Protected.ExtraHeaders["url"]; url != server.URL+"/key-change" {
+			t.Errorf("unexpected inner url: %v", url)
+		}
+		payload, err := inner.Verify(newKey.Public())
+		if err != nil {
+			t.Fatalf("inner jws not signed by new key: %v", err)
+		}
+
+		keyChange := struct {
+			Account string          `json:"account"`
+			OldKey  jose.JSONWebKey `json:"oldKey"`
+		}{}
+		if err := json.Unmarshal(payload, &keyChange); err != nil {
+			t.Fatalf("failed to parse key change payload: %v", err)
+		}
+		if keyChange.Account != accountUrl {
+			t.Errorf("unexpected account: %s", keyChange.Account)
+		}
+		want, _ := (&jose.JSONWebKey{Key: oldKey.Public()}).Thumbprint(crypto.SHA256)
+		got, _ := keyChange.OldKey.Thumbprint(crypto.SHA256)
+		if string(got) != string(want) {
+			t.Error("old key in payload does not match")
+		}
+
+		rolled = true
+	})
+
+	server = httptest.NewServer(mux)
+	defer server.Close()
+	accountUrl = server.URL + "/account/1"
+
+	if err := rolloverAccountKey(server.Client(), server.URL+"/directory", accountUrl, oldKey, newKey); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rolled {
+		t.Error("key change request was not accepted")
+	}
+}
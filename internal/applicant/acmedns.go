@@ -0,0 +1,227 @@
+package applicant
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cpu/goacmedns"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns/acmedns"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+	"certimate/internal/utils/app"
+)
+
+// 同一授权可能被多个申请同时使用，写回注册信息时需串行，避免互相覆盖。
+var acmeDnsRegistrationsMu sync.Mutex
+
+type acmeDnsApplicant struct {
+	option *ApplyOption
+}
+
+func NewAcmeDns(option *ApplyOption) Applicant {
+	return &acmeDnsApplicant{
+		option: option,
+	}
+}
+
+func (a *acmeDnsApplicant) Apply() (*Certificate, error) {
+	dnsProvider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, dnsProvider)
+}
+
+func (a *acmeDnsApplicant) newDNSProvider() (challenge.Provider, error) {
+	return a.newDNSProviderWithSave(saveAcmeDnsRegistration)
+}
+
+func (a *acmeDnsApplicant) newDNSProviderWithSave(save acmeDnsSaveFunc) (challenge.Provider, error) {
+	access := &domain.AcmeDnsAccess{}
+	if err := json.Unmarshal([]byte(a.option.Access), access); err != nil {
+		return nil, err
+	}
+
+	if access.ApiBase == "" {
+		return nil, errors.New("acme-dns api base is empty")
+	}
+
+	storage := &acmeDnsStorage{
+		accessId: a.option.AccessId,
+		accounts: make(map[string]goacmedns.Account),
+		pending:  make(map[string]goacmedns.Account),
+		save:     save,
+	}
+	for name, registration := range access.Registrations {
+		storage.accounts[name] = toAcmeDnsAccount(registration)
+	}
+
+	client := &acmeDnsClient{
+		Client:    goacmedns.NewClient(strings.TrimRight(access.ApiBase, "/")),
+		allowFrom: access.AllowFrom,
+	}
+
+	provider, err := acmedns.NewDNSProviderClient(client, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &acmeDnsProvider{
+		Provider:     provider,
+		storage:      storage,
+		autoRegister: access.AutoRegister,
+		logger:       a.option.Logger,
+	}
+
+	return withPropagationTimeout(p, getPropagationTimeout(a.option)), nil
+}
+
+// 在 lego 的 acme-dns 提供商基础上，处理未注册域名并在日志中输出需要添加的 CNAME 记录。
+type acmeDnsProvider struct {
+	challenge.Provider
+	storage      *acmeDnsStorage
+	autoRegister bool
+	logger       deployer.Logger
+}
+
+func (p *acmeDnsProvider) Present(domain, token, keyAuth string) error {
+	if _, err := p.storage.Fetch(domain); errors.Is(err, goacmedns.ErrDomainNotFound) && !p.autoRegister {
+		return fmt.Errorf("acme-dns: domain %q is not registered, enable auto register or add the registration to the access", domain)
+	}
+
+	err := p.Provider.Present(domain, token, keyAuth)
+
+	var cnameErr acmedns.ErrCNAMERequired
+	if errors.As(err, &cnameErr) {
+		p.logger.Logf("acme-dns 已为 [%s] 注册账户，请添加 CNAME 记录后重新申请: %s CNAME %s.", cnameErr.Domain, cnameErr.FQDN, cnameErr.Target)
+	}
+
+	return err
+}
+
+// 注册时附带来源网段限制，lego 自身不支持该选项。
+type acmeDnsClient struct {
+	goacmedns.Client
+	allowFrom []string
+}
+
+func (c *acmeDnsClient) RegisterAccount(_ []string) (goacmedns.Account, error) {
+	return c.Client.RegisterAccount(c.allowFrom)
+}
+
+type acmeDnsSaveFunc func(accessId string, registrations map[string]domain.AcmeDnsRegistration) error
+
+// 以授权记录保存 acme-dns 注册信息，新注册的账户在 Save 时写回授权配置。
+type acmeDnsStorage struct {
+	mu       sync.Mutex
+	accessId string
+	accounts map[string]goacmedns.Account
+	pending  map[string]goacmedns.Account
+	save     acmeDnsSaveFunc
+}
+
+func (s *acmeDnsStorage) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending) == 0 {
+		return nil
+	}
+
+	registrations := make(map[string]domain.AcmeDnsRegistration, len(s.pending))
+	for name, account := range s.pending {
+		registrations[name] = toAcmeDnsRegistration(account)
+	}
+
+	if err := s.save(s.accessId, registrations); err != nil {
+		return fmt.Errorf("failed to save acme-dns registrations: %w", err)
+	}
+
+	clear(s.pending)
+	return nil
+}
+
+func (s *acmeDnsStorage) Put(domain string, account goacmedns.Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accounts[domain] = account
+	s.pending[domain] = account
+	return nil
+}
+
+func (s *acmeDnsStorage) Fetch(domain string) (goacmedns.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if account, ok := s.accounts[domain]; ok {
+		return account, nil
+	}
+
+	return goacmedns.Account{}, goacmedns.ErrDomainNotFound
+}
+
+func (s *acmeDnsStorage) FetchAll() map[string]goacmedns.Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := make(map[string]goacmedns.Account, len(s.accounts))
+	for name, account := range s.accounts {
+		accounts[name] = account
+	}
+
+	return accounts
+}
+
+func toAcmeDnsAccount(registration domain.AcmeDnsRegistration) goacmedns.Account {
+	return goacmedns.Account{
+		FullDomain: registration.FullDomain,
+		SubDomain:  registration.SubDomain,
+		Username:   registration.Username,
+		Password:   registration.Password,
+		ServerURL:  registration.ServerUrl,
+	}
+}
+
+func toAcmeDnsRegistration(account goacmedns.Account) domain.AcmeDnsRegistration {
+	return domain.AcmeDnsRegistration{
+		FullDomain: account.FullDomain,
+		SubDomain:  account.SubDomain,
+		Username:   account.Username,
+		Password:   account.Password,
+		ServerUrl:  account.ServerURL,
+	}
+}
+
+// 重新读取授权记录并合并新注册的账户，避免覆盖期间其他申请或用户所做的修改。
+func saveAcmeDnsRegistration(accessId string, registrations map[string]domain.AcmeDnsRegistration) error {
+	acmeDnsRegistrationsMu.Lock()
+	defer acmeDnsRegistrationsMu.Unlock()
+
+	dao := app.GetApp().Dao()
+	record, err := dao.FindRecordById("access", accessId)
+	if err != nil {
+		return err
+	}
+
+	access := &domain.AcmeDnsAccess{}
+	if err := record.UnmarshalJSONField("config", access); err != nil {
+		return err
+	}
+
+	if access.Registrations == nil {
+		access.Registrations = make(map[string]domain.AcmeDnsRegistration)
+	}
+	for name, registration := range registrations {
+		access.Registrations[name] = registration
+	}
+
+	record.Set("config", access)
+	return dao.SaveRecord(record)
+}
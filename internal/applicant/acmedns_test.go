@@ -0,0 +1,144 @@
+package applicant
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/providers/dns/acmedns"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+)
+
+type testAcmeDnsServer struct {
+	registered []string
+	updates    map[string]string
+}
+
+func startTestAcmeDnsServer(t *testing.T) (*testAcmeDnsServer, string) {
+	s := &testAcmeDnsServer{updates: make(map[string]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/register", func(w http.ResponseWriter, r *http.Request) {
+		req := struct{ AllowFrom []string }{}
+		json.NewDecoder(r.Body).Decode(&req)
+		s.registered = append(s.registered, req.AllowFrom...)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{
+			"fulldomain": "d420c923.auth.example.test",
+			"subdomain":  "d420c923",
+			"username":   "new-user",
+			"password":   "new-password",
+		})
+	})
+	mux.HandleFunc("/update", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-User") != "user" || r.Header.Get("X-Api-Key") != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		req := struct{ SubDomain, Txt string }{}
+		json.NewDecoder(r.Body).Decode(&req)
+		s.updates[req.SubDomain] = req.Txt
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return s, server.URL
+}
+
+func newTestAcmeDnsApplicant(access *domain.AcmeDnsAccess) *acmeDnsApplicant {
+	data, _ := json.Marshal(access)
+	return &acmeDnsApplicant{option: &ApplyOption{Access: string(data), AccessId: "access-id", Timeout: 60, Logger: deployer.NewNilLogger()}}
+}
+
+func TestAcmeDnsPresentWithRegistration(t *testing.T) {
+	server, url := startTestAcmeDnsServer(t)
+
+	applicant := newTestAcmeDnsApplicant(&domain.AcmeDnsAccess{
+		ApiBase: url,
+		Registrations: map[string]domain.AcmeDnsRegistration{
+			"example.com": {SubDomain: "sub", Username: "user", Password: "password"},
+		},
+	})
+	provider, err := applicant.newDNSProviderWithSave(func(string, map[string]domain.AcmeDnsRegistration) error {
+		t.Error("unexpected save")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+
+	if want := dns01.GetChallengeInfo("example.com", "keyAuth").Value; server.updates["sub"] != want {
+		t.Errorf("unexpected txt value: %s", server.updates["sub"])
+	}
+}
+
+func TestAcmeDnsPresentAutoRegister(t *testing.T) {
+	server, url := startTestAcmeDnsServer(t)
+
+	applicant := newTestAcmeDnsApplicant(&domain.AcmeDnsAccess{
+		ApiBase:      url,
+		AllowFrom:    []string{"10.0.0.0/8"},
+		AutoRegister: true,
+	})
+
+	var saved map[string]domain.AcmeDnsRegistration
+	provider, err := applicant.newDNSProviderWithSave(func(accessId string, registrations map[string]domain.AcmeDnsRegistration) error {
+		if accessId != "access-id" {
+			t.Errorf("unexpected access id: %s", accessId)
+		}
+		saved = registrations
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	var cnameErr acmedns.ErrCNAMERequired
+	if !errors.As(err, &cnameErr) {
+		t.Fatalf("expected cname required error, got: %v", err)
+	}
+	if cnameErr.FQDN != "_acme-challenge.example.com." || cnameErr.Target != "d420c923.auth.example.test" {
+		t.Errorf("unexpected cname: %s -> %s", cnameErr.FQDN, cnameErr.Target)
+	}
+
+	if !slices.Equal(server.registered, []string{"10.0.0.0/8"}) {
+		t.Errorf("unexpected allow from: %v", server.registered)
+	}
+	if registration, ok := saved["example.com"]; !ok || registration.Username != "new-user" || registration.ServerUrl != url {
+		t.Errorf("unexpected saved registrations: %v", saved)
+	}
+}
+
+func TestAcmeDnsPresentWithoutRegistration(t *testing.T) {
+	server, url := startTestAcmeDnsServer(t)
+
+	applicant := newTestAcmeDnsApplicant(&domain.AcmeDnsAccess{ApiBase: url})
+	provider, err := applicant.newDNSProviderWithSave(func(string, map[string]domain.AcmeDnsRegistration) error {
+		t.Error("unexpected save")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err == nil {
+		t.Error("expected error for unregistered domain")
+	}
+	if len(server.registered) != 0 {
+		t.Error("unexpected registration")
+	}
+}
@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/providers/dns/alidns"
 
 	"certimate/internal/domain"
@@ -21,16 +20,22 @@ func NewAliyun(option *ApplyOption) Applicant {
 }
 
 func (a *aliyun) Apply() (*Certificate, error) {
-	access := &domain.AliyunAccess{}
-	json.Unmarshal([]byte(a.option.Access), access)
-
-	os.Setenv("ALICLOUD_ACCESS_KEY", access.AccessKeyId)
-	os.Setenv("ALICLOUD_SECRET_KEY", access.AccessKeySecret)
-	os.Setenv("ALICLOUD_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-	dnsProvider, err := alidns.NewDNSProvider()
+	dnsProvider, err := a.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(a.option, dnsProvider)
 }
+
+func (a *aliyun) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.AliyunAccess{}
+	json.Unmarshal([]byte(a.option.Access), access)
+
+	config := newDefaultConfig(alidns.NewDefaultConfig)
+	config.APIKey = access.AccessKeyId
+	config.SecretKey = access.AccessKeySecret
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return alidns.NewDNSProviderConfig(config)
+}
@@ -1,17 +1,18 @@
 package applicant
 
 import (
+	"context"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"net"
 	"strings"
 
 	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
 	"certimate/internal/pkg/utils/x509"
 	"certimate/internal/repository"
 	"certimate/internal/utils/app"
@@ -19,7 +20,6 @@ import (
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/challenge"
-	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/pocketbase/pocketbase/models"
@@ -36,28 +36,40 @@ const (
 	configTypePdns        = "pdns"
 	configTypeHttpreq     = "httpreq"
 	configTypeVolcengine  = "volcengine"
+	configTypeLego        = "lego"
+	configTypeRfc2136     = "rfc2136"
+	configTypeAcmeDns     = "acmedns"
+	configTypeManual      = "manual"
+	configTypeSSH         = "ssh"
 )
 
 const defaultSSLProvider = "letsencrypt"
 const (
 	sslProviderLetsencrypt = "letsencrypt"
-	sslProviderZeroSSL     = "zerossl"
-	sslProviderGts         = "gts"
+	// Let's Encrypt 测试环境，仅在申请配置启用测试环境时使用。
+	sslProviderLetsencryptStaging = "letsencrypt_staging"
+	sslProviderZeroSSL            = "zerossl"
+	sslProviderGts                = "gts"
+	sslProviderCustom             = "custom"
+	sslProviderInternal           = "internal"
 )
 
 const (
-	zerosslUrl     = "https://acme.zerossl.com/v2/DV90"
-	letsencryptUrl = "https://acme-v02.api.letsencrypt.org/directory"
-	gtsUrl         = "https://dv.acme-v02.api.pki.goog/directory"
+	zerosslUrl            = "https://acme.zerossl.com/v2/DV90"
+	letsencryptUrl        = "https://acme-v02.api.letsencrypt.org/directory"
+	letsencryptStagingUrl = "https://acme-staging-v02.api.letsencrypt.org/directory"
+	gtsUrl                = "https://dv.acme-v02.api.pki.goog/directory"
 )
 
 var sslProviderUrls = map[string]string{
-	sslProviderLetsencrypt: letsencryptUrl,
-	sslProviderZeroSSL:     zerosslUrl,
-	sslProviderGts:         gtsUrl,
+	sslProviderLetsencrypt:        letsencryptUrl,
+	sslProviderLetsencryptStaging: letsencryptStagingUrl,
+	sslProviderZeroSSL:            zerosslUrl,
+	sslProviderGts:                gtsUrl,
 }
 
-const defaultEmail = "536464346@qq.com"
+// ACME 账户的联系邮箱未配置时返回的错误，不再使用默认邮箱代为注册。
+var errEmailRequired = errors.New("contact email is not configured, please set the email in the apply config")
 
 const defaultTimeout = 60
 
@@ -68,16 +80,34 @@ type Certificate struct {
 	Certificate       string `json:"certificate"`
 	IssuerCertificate string `json:"issuerCertificate"`
 	Csr               string `json:"csr"`
+	Ca                string `json:"ca"`
+	// 申请时的主 CA，由备用 CA 签发时与 Ca 不同。
+	PrimaryCa string `json:"primaryCa"`
+	// 是否由 CA 测试环境签发，不受信任。
+	Untrusted bool `json:"untrusted"`
 }
 
 type ApplyOption struct {
-	Email              string `json:"email"`
-	Domain             string `json:"domain"`
-	Access             string `json:"access"`
-	KeyAlgorithm       string `json:"keyAlgorithm"`
-	Nameservers        string `json:"nameservers"`
-	Timeout            int64  `json:"timeout"`
-	DisableFollowCNAME bool   `json:"disableFollowCNAME"`
+	Email                string                         `json:"email"`
+	DomainId             string                         `json:"domainId"`
+	Domain               string                         `json:"domain"`
+	Access               string                         `json:"access"`
+	AccessId             string                         `json:"accessId"`
+	KeyAlgorithm         string                         `json:"keyAlgorithm"`
+	Nameservers          string                         `json:"nameservers"`
+	Timeout              int64                          `json:"timeout"`
+	DisableFollowCNAME   bool                           `json:"disableFollowCNAME"`
+	SSLProvider          string                         `json:"sslProvider"`
+	SSLProviderFallbacks []string                       `json:"sslProviderFallbacks"`
+	PreferredChain       string                         `json:"preferredChain"`
+	PrivateKey           string                         `json:"privateKey"`
+	Csr                  string                         `json:"csr"`
+	HttpChallenge        *domain.HttpChallengeConfig    `json:"httpChallenge"`
+	TlsAlpnChallenge     *domain.TlsAlpnChallengeConfig `json:"tlsAlpnChallenge"`
+	InternalCA           *domain.InternalCAConfig       `json:"internalCA"`
+	Staging              bool                           `json:"staging"`
+	Profile              string                         `json:"profile"`
+	Logger               deployer.Logger                `json:"-"`
 }
 
 type ApplyUser struct {
@@ -140,6 +170,10 @@ type Applicant interface {
 }
 
 func Get(record *models.Record) (Applicant, error) {
+	return GetWithLogger(record, deployer.NewNilLogger())
+}
+
+func GetWithLogger(record *models.Record, logger deployer.Logger) (Applicant, error) {
 	if record.GetString("applyConfig") == "" {
 		return nil, errors.New("applyConfig is empty")
 	}
@@ -147,30 +181,109 @@ func Get(record *models.Record) (Applicant, error) {
 	applyConfig := &domain.ApplyConfig{}
 	record.UnmarshalJSONField("applyConfig", applyConfig)
 
-	access, err := app.GetApp().Dao().FindRecordById("access", applyConfig.Access)
+	return getWithApplyConfig(applyConfig, record.Id, record.GetString("domain"), record.GetString("privateKey"), logger)
+}
+
+// 使用指定的申请配置为 CSR 中的域名签发证书，Certimate 不持有私钥。
+//
+// 入参：
+//   - applyConfig: 申请配置。
+//   - csrPem: PEM 格式的 CSR。
+//   - logger: 日志记录器。
+//
+// 出参：
+//   - 申请器。
+//   - 错误。
+func GetForCSR(applyConfig domain.ApplyConfig, csrPem string, logger deployer.Logger) (Applicant, error) {
+	csr, err := certcrypto.PemDecodeTox509CSR([]byte(csrPem))
 	if err != nil {
-		return nil, fmt.Errorf("access record not found: %w", err)
+		return nil, fmt.Errorf("failed to parse csr: %w", err)
 	}
 
-	if applyConfig.Email == "" {
-		applyConfig.Email = defaultEmail
-	}
+	applyConfig.KeyMode = domain.KeyModeCSR
+	applyConfig.Csr = csrPem
 
+	return getWithApplyConfig(&applyConfig, "", strings.Join(getCsrSANs(csr), ";"), "", logger)
+}
+
+func getWithApplyConfig(applyConfig *domain.ApplyConfig, domainId string, domains string, privateKey string, logger deployer.Logger) (Applicant, error) {
 	if applyConfig.Timeout == 0 {
 		applyConfig.Timeout = defaultTimeout
 	}
 
 	option := &ApplyOption{
-		Email:              applyConfig.Email,
-		Domain:             record.GetString("domain"),
-		Access:             access.GetString("config"),
-		KeyAlgorithm:       applyConfig.KeyAlgorithm,
-		Nameservers:        applyConfig.Nameservers,
-		Timeout:            applyConfig.Timeout,
-		DisableFollowCNAME: applyConfig.DisableFollowCNAME,
+		Email:                applyConfig.Email,
+		DomainId:             domainId,
+		Domain:               domains,
+		KeyAlgorithm:         applyConfig.KeyAlgorithm,
+		Nameservers:          applyConfig.Nameservers,
+		Timeout:              applyConfig.Timeout,
+		DisableFollowCNAME:   applyConfig.DisableFollowCNAME,
+		SSLProvider:          applyConfig.SSLProvider,
+		SSLProviderFallbacks: applyConfig.SSLProviderFallbacks,
+		PreferredChain:       applyConfig.PreferredChain,
+		HttpChallenge:        applyConfig.HttpChallenge,
+		TlsAlpnChallenge:     applyConfig.TlsAlpnChallenge,
+		InternalCA:           applyConfig.InternalCA,
+		Staging:              applyConfig.Staging,
+		Profile:              applyConfig.Profile,
+		Logger:               logger,
+	}
+
+	if err := setKeyModeOption(option, applyConfig, privateKey); err != nil {
+		return nil, err
+	}
+
+	// 使用内置 CA 时直接签发，不需要 ACME 验证
+	sslProvider, err := getSSLProviderConfig(applyConfig.SSLProvider)
+	if err != nil {
+		return nil, err
+	}
+	if sslProvider.Provider == sslProviderInternal {
+		return NewInternalCA(option), nil
+	}
+
+	if option.Email == "" {
+		return nil, errEmailRequired
+	}
+
+	switch applyConfig.GetChallengeType() {
+	case domain.ChallengeTypeDNS01:
+		// IP 地址标识只能通过 HTTP-01 或 TLS-ALPN-01 验证（RFC 8738）
+		for _, san := range strings.Split(domains, ";") {
+			if net.ParseIP(strings.TrimSpace(san)) != nil {
+				return nil, fmt.Errorf("ip address %s cannot be validated with dns-01 challenge, please use http-01 or tls-alpn-01", san)
+			}
+		}
+
+		return getWithDNS01(applyConfig, option)
+	case domain.ChallengeTypeHTTP01:
+		return NewHttpChallenge(option), nil
+	case domain.ChallengeTypeTLSALPN01:
+		return NewTlsAlpnChallenge(option), nil
+	default:
+		return nil, fmt.Errorf("unsupported challenge type: %s", applyConfig.ChallengeType)
 	}
+}
 
-	switch access.GetString("configType") {
+func getWithDNS01(applyConfig *domain.ApplyConfig, option *ApplyOption) (Applicant, error) {
+	access, err := app.GetApp().Dao().FindRecordById("access", applyConfig.Access)
+	if err != nil {
+		return nil, fmt.Errorf("access record not found: %w", err)
+	}
+
+	option.Access = access.GetString("config")
+	option.AccessId = access.Id
+
+	if len(applyConfig.DnsAliases) > 0 || len(applyConfig.DnsProviders) > 0 {
+		return newDnsRoutingApplicant(applyConfig, option, access.GetString("configType"))
+	}
+
+	return newDNSApplicant(access.GetString("configType"), option)
+}
+
+func newDNSApplicant(configType string, option *ApplyOption) (Applicant, error) {
+	switch configType {
 	case configTypeAliyun:
 		return NewAliyun(option), nil
 	case configTypeTencent:
@@ -191,19 +304,29 @@ func Get(record *models.Record) (Applicant, error) {
 		return NewHttpreq(option), nil
 	case configTypeVolcengine:
 		return NewVolcengine(option), nil
+	case configTypeLego:
+		return NewLego(option), nil
+	case configTypeRfc2136:
+		return NewRfc2136(option), nil
+	case configTypeAcmeDns:
+		return NewAcmeDns(option), nil
+	case configTypeManual:
+		return NewManualDns(option)
 	default:
 		return nil, errors.New("unknown config type")
 	}
 }
 
 type SSLProviderConfig struct {
-	Config   SSLProviderConfigContent `json:"config"`
-	Provider string                   `json:"provider"`
+	Config    SSLProviderConfigContent `json:"config"`
+	Provider  string                   `json:"provider"`
+	Fallbacks []string                 `json:"fallbacks"`
 }
 
 type SSLProviderConfigContent struct {
-	Zerossl SSLProviderEab `json:"zerossl"`
-	Gts     SSLProviderEab `json:"gts"`
+	Zerossl SSLProviderEab    `json:"zerossl"`
+	Gts     SSLProviderEab    `json:"gts"`
+	Custom  SSLProviderCustom `json:"custom"`
 }
 
 type SSLProviderEab struct {
@@ -211,48 +334,81 @@ type SSLProviderEab struct {
 	EabKid     string `json:"eabKid"`
 }
 
+type SSLProviderCustom struct {
+	SSLProviderEab
+	// ACME 目录地址，如 step-ca、Pebble、Boulder 或商业 CA 提供的地址。
+	Url string `json:"url"`
+	// 测试环境的 ACME 目录地址，申请配置启用测试环境时使用。
+	StagingUrl string `json:"stagingUrl"`
+	// 访问 ACME 目录时用于校验服务端证书的根证书，PEM 格式，可包含多个证书。
+	// 为空时使用系统根证书。
+	CaCertificates string `json:"caCertificates"`
+}
+
 func apply(option *ApplyOption, provider challenge.Provider) (*Certificate, error) {
-	record, _ := app.GetApp().Dao().FindFirstRecordByFilter("settings", "name='ssl-provider'")
+	release := dns01Settings.acquire(option.DisableFollowCNAME, parseNameservers(option.Nameservers))
+	defer release()
+
+	return applyWithChallenge(option, func(client *lego.Client) error {
+		return client.Challenge.SetDNS01Provider(provider)
+	})
+}
 
-	sslProvider := &SSLProviderConfig{
-		Config:   SSLProviderConfigContent{},
-		Provider: defaultSSLProvider,
+func applyWithChallenge(option *ApplyOption, setChallenge func(client *lego.Client) error) (*Certificate, error) {
+	sslProvider, err := getSSLProviderConfig(option.SSLProvider)
+	if err != nil {
+		return nil, err
 	}
-	if record != nil {
-		if err := record.UnmarshalJSONField("content", sslProvider); err != nil {
+
+	candidates := getSSLProviderCandidates(sslProvider, option.SSLProviderFallbacks)
+	if option.Staging {
+		sslProvider, err = getSSLProviderStagingConfig(sslProvider)
+		if err != nil {
 			return nil, err
 		}
+
+		// 测试环境仅用于验证申请流程，不切换备用 CA
+		candidates = []string{sslProvider.Provider}
+		option.Logger.Logf("使用 CA 测试环境申请证书，签发的证书不受信任")
 	}
 
-	// Some unified lego environment variables are configured here.
-	// link: https://github.com/go-acme/lego/issues/1867
-	os.Setenv("LEGO_DISABLE_CNAME_SUPPORT", strconv.FormatBool(option.DisableFollowCNAME))
+	// 按顺序尝试主 CA 及备用 CA，仅在限流、服务端错误、超时等与 CA 自身相关的错误时切换到下一个
+	var lastErr error
+	for _, provider := range candidates {
+		candidate := *sslProvider
+		candidate.Provider = provider
 
-	myUser, err := newApplyUser(sslProvider.Provider, option.Email)
-	if err != nil {
-		return nil, err
-	}
+		option.Logger.Logf("使用 CA [%s] 申请证书", provider)
 
-	config := lego.NewConfig(myUser)
+		certificate, err := applyWithSSLProvider(option, &candidate, setChallenge)
+		if err == nil {
+			option.Logger.Logf("CA [%s] 签发证书成功", provider)
+			certificate.PrimaryCa = getSSLProviderAccountCA(sslProvider)
+			certificate.Untrusted = option.Staging
+			return certificate, nil
+		}
 
-	// This CA URL is configured for a local dev instance of Boulder running in Docker in a VM.
-	config.CADirURL = sslProviderUrls[sslProvider.Provider]
-	config.Certificate.KeyType = parseKeyAlgorithm(option.KeyAlgorithm)
+		option.Logger.Logf("CA [%s] 申请证书失败: %v", provider, err)
+		lastErr = err
 
-	// A client facilitates communication with the CA server.
-	client, err := lego.NewClient(config)
+		if !isSSLProviderFailoverError(err) {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+func applyWithSSLProvider(option *ApplyOption, sslProvider *SSLProviderConfig, setChallenge func(client *lego.Client) error) (*Certificate, error) {
+	client, myUser, err := newClientWithProfile(sslProvider, option.Email, option.KeyAlgorithm, option.Profile)
 	if err != nil {
 		return nil, err
 	}
 
-	challengeOptions := make([]dns01.ChallengeOption, 0)
-	nameservers := parseNameservers(option.Nameservers)
-	if len(nameservers) > 0 {
-		challengeOptions = append(challengeOptions, dns01.AddRecursiveNameservers(nameservers))
+	if err := setChallenge(client); err != nil {
+		return nil, err
 	}
 
-	client.Challenge.SetDNS01Provider(provider, challengeOptions...)
-
 	// New users will need to register
 	if !myUser.hasRegistration() {
 		reg, err := getReg(client, sslProvider, myUser)
@@ -262,12 +418,7 @@ func apply(option *ApplyOption, provider challenge.Provider) (*Certificate, erro
 		myUser.Registration = reg
 	}
 
-	domains := strings.Split(option.Domain, ";")
-	request := certificate.ObtainRequest{
-		Domains: domains,
-		Bundle:  true,
-	}
-	certificates, err := client.Certificate.Obtain(request)
+	certificates, err := obtain(client, option)
 	if err != nil {
 		return nil, err
 	}
@@ -279,15 +430,109 @@ func apply(option *ApplyOption, provider challenge.Provider) (*Certificate, erro
 		Certificate:       string(certificates.Certificate),
 		IssuerCertificate: string(certificates.IssuerCertificate),
 		Csr:               string(certificates.CSR),
+		Ca:                getSSLProviderAccountCA(sslProvider),
 	}, nil
 }
 
+func obtain(client *lego.Client, option *ApplyOption) (*certificate.Resource, error) {
+	// 使用上传的 CSR 签发时，Certimate 不持有私钥
+	if option.Csr != "" {
+		csr, err := certcrypto.PemDecodeTox509CSR([]byte(option.Csr))
+		if err != nil {
+			return nil, err
+		}
+
+		return client.Certificate.ObtainForCSR(certificate.ObtainForCSRRequest{
+			CSR:            csr,
+			Bundle:         true,
+			PreferredChain: option.PreferredChain,
+		})
+	}
+
+	// lego 以第一个标识作为证书主题的通用名称，优先使用域名而非 IP 地址
+	domains := make([]string, 0)
+	ips := make([]string, 0)
+	for _, san := range strings.Split(option.Domain, ";") {
+		if net.ParseIP(san) != nil {
+			ips = append(ips, san)
+		} else {
+			domains = append(domains, san)
+		}
+	}
+	domains = append(domains, ips...)
+
+	request := certificate.ObtainRequest{
+		Domains:        domains,
+		Bundle:         true,
+		PreferredChain: option.PreferredChain,
+	}
+
+	if option.PrivateKey != "" {
+		privateKey, err := certcrypto.ParsePEMPrivateKey([]byte(option.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+		request.PrivateKey = privateKey
+	}
+
+	return client.Certificate.Obtain(request)
+}
+
+func newClient(sslProvider *SSLProviderConfig, email string, keyAlgorithm string) (*lego.Client, *ApplyUser, error) {
+	caDirUrl, err := getSSLProviderUrl(sslProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	myUser, err := newApplyUser(getSSLProviderAccountCA(sslProvider), email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := newClientWithUser(sslProvider, caDirUrl, myUser, keyAlgorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, myUser, nil
+}
+
+func newClientWithUser(sslProvider *SSLProviderConfig, caDirUrl string, myUser *ApplyUser, keyAlgorithm string) (*lego.Client, error) {
+	config, err := newClientConfig(sslProvider, caDirUrl, myUser, keyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	// A client facilitates communication with the CA server.
+	return lego.NewClient(config)
+}
+
+func newClientConfig(sslProvider *SSLProviderConfig, caDirUrl string, myUser *ApplyUser, keyAlgorithm string) (*lego.Config, error) {
+	config := lego.NewConfig(myUser)
+
+	config.CADirURL = caDirUrl
+	config.Certificate.KeyType = parseKeyAlgorithm(keyAlgorithm)
+
+	if sslProvider.Provider == sslProviderCustom && sslProvider.Config.Custom.CaCertificates != "" {
+		httpClient, err := newSSLProviderHttpClient(sslProvider.Config.Custom.CaCertificates, config.HTTPClient.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		config.HTTPClient = httpClient
+	}
+
+	return config, nil
+}
+
 type AcmeAccountRepository interface {
 	GetByCAAndEmail(ca, email string) (*domain.AcmeAccount, error)
+	GetById(ctx context.Context, id string) (*domain.AcmeAccount, error)
+	List(ctx context.Context) ([]*domain.AcmeAccount, error)
 	Save(ca, email, key string, resource *registration.Resource) error
+	Update(ctx context.Context, account *domain.AcmeAccount) error
 }
 
-func getAcmeAccountRepository() AcmeAccountRepository {
+var getAcmeAccountRepository = func() AcmeAccountRepository {
 	return repository.NewAcmeAccountRepository()
 }
 
@@ -308,9 +553,20 @@ func getReg(client *lego.Client, sslProvider *SSLProviderConfig, user *ApplyUser
 			HmacEncoded:          sslProvider.Config.Gts.EabHmacKey,
 		})
 
-	case sslProviderLetsencrypt:
+	case sslProviderLetsencrypt, sslProviderLetsencryptStaging:
 		reg, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
 
+	case sslProviderCustom:
+		if sslProvider.Config.Custom.EabKid != "" {
+			reg, err = client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+				TermsOfServiceAgreed: true,
+				Kid:                  sslProvider.Config.Custom.EabKid,
+				HmacEncoded:          sslProvider.Config.Custom.EabHmacKey,
+			})
+		} else {
+			reg, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+		}
+
 	default:
 		err = errors.New("unknown ssl provider")
 	}
@@ -321,13 +577,14 @@ func getReg(client *lego.Client, sslProvider *SSLProviderConfig, user *ApplyUser
 
 	repo := getAcmeAccountRepository()
 
-	resp, err := repo.GetByCAAndEmail(sslProvider.Provider, user.GetEmail())
+	ca := getSSLProviderAccountCA(sslProvider)
+	resp, err := repo.GetByCAAndEmail(ca, user.GetEmail())
 	if err == nil {
 		user.key = resp.Key
 		return resp.Resource, nil
 	}
 
-	if err := repo.Save(sslProvider.Provider, user.GetEmail(), user.getPrivateKeyString(), reg); err != nil {
+	if err := repo.Save(ca, user.GetEmail(), user.getPrivateKeyString(), reg); err != nil {
 		return nil, fmt.Errorf("failed to save registration: %w", err)
 	}
 
@@ -0,0 +1,101 @@
+package applicant
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/registration"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
+)
+
+type testAcmeAccountRepository struct {
+	saved []string
+}
+
+func (r *testAcmeAccountRepository) GetByCAAndEmail(ca, email string) (*domain.AcmeAccount, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *testAcmeAccountRepository) GetById(ctx context.Context, id string) (*domain.AcmeAccount, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *testAcmeAccountRepository) List(ctx context.Context) ([]*domain.AcmeAccount, error) {
+	return nil, nil
+}
+
+func (r *testAcmeAccountRepository) Save(ca, email, key string, resource *registration.Resource) error {
+	r.saved = append(r.saved, ca)
+	return nil
+}
+
+func (r *testAcmeAccountRepository) Update(ctx context.Context, account *domain.AcmeAccount) error {
+	return nil
+}
+
+func TestGetRegStaging(t *testing.T) {
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/directory", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"newNonce":   server.URL + "/new-nonce",
+			"newAccount": server.URL + "/new-account",
+			"newOrder":   server.URL + "/new-order",
+			"revokeCert": server.URL + "/revoke-cert",
+			"keyChange":  server.URL + "/key-change",
+		})
+	})
+	mux.HandleFunc("/new-nonce", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", "nonce")
+	})
+	mux.HandleFunc("/new-account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", "nonce")
+		w.Header().Set("Location", server.URL+"/account/1")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"status":"valid"}`))
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	repo := &testAcmeAccountRepository{}
+	getAcmeAccountRepositoryOrig := getAcmeAccountRepository
+	getAcmeAccountRepository = func() AcmeAccountRepository { return repo }
+	defer func() { getAcmeAccountRepository = getAcmeAccountRepositoryOrig }()
+
+	sslProvider, err := getSSLProviderStagingConfig(&SSLProviderConfig{Provider: sslProviderLetsencrypt})
+	if err != nil {
+		t.Fatalf("failed to get staging config: %v", err)
+	}
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keyPem, _ := x509.ConvertECPrivateKeyToPEM(key)
+	user := &ApplyUser{Ca: getSSLProviderAccountCA(sslProvider), Email: "admin@example.com", key: keyPem}
+
+	config := lego.NewConfig(user)
+	config.CADirURL = server.URL + "/directory"
+	client, err := lego.NewClient(config)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	reg, err := getReg(client, sslProvider, user)
+	if err != nil {
+		t.Fatalf("failed to register staging account: %v", err)
+	}
+	if reg.URI != server.URL+"/account/1" {
+		t.Errorf("unexpected account uri: %s", reg.URI)
+	}
+	if len(repo.saved) != 1 || repo.saved[0] != sslProviderLetsencryptStaging {
+		t.Errorf("unexpected saved accounts: %v", repo.saved)
+	}
+}
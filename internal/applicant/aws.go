@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/providers/dns/route53"
 
 	"certimate/internal/domain"
@@ -21,19 +20,24 @@ func NewAws(option *ApplyOption) Applicant {
 }
 
 func (t *aws) Apply() (*Certificate, error) {
-	access := &domain.AwsAccess{}
-	json.Unmarshal([]byte(t.option.Access), access)
-
-	os.Setenv("AWS_REGION", access.Region)
-	os.Setenv("AWS_ACCESS_KEY_ID", access.AccessKeyId)
-	os.Setenv("AWS_SECRET_ACCESS_KEY", access.SecretAccessKey)
-	os.Setenv("AWS_HOSTED_ZONE_ID", access.HostedZoneId)
-	os.Setenv("AWS_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", t.option.Timeout))
-
-	dnsProvider, err := route53.NewDNSProvider()
+	dnsProvider, err := t.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(t.option, dnsProvider)
 }
+
+func (t *aws) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.AwsAccess{}
+	json.Unmarshal([]byte(t.option.Access), access)
+
+	config := newDefaultConfig(route53.NewDefaultConfig)
+	config.Region = access.Region
+	config.AccessKeyID = access.AccessKeyId
+	config.SecretAccessKey = access.SecretAccessKey
+	config.HostedZoneID = access.HostedZoneId
+	config.PropagationTimeout = getPropagationTimeout(t.option)
+
+	return newDNSProviderConfig(route53.NewDNSProviderConfig, config)
+}
@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	cf "github.com/go-acme/lego/v4/providers/dns/cloudflare"
 
 	"certimate/internal/domain"
@@ -21,16 +20,22 @@ func NewCloudflare(option *ApplyOption) Applicant {
 }
 
 func (c *cloudflare) Apply() (*Certificate, error) {
-	access := &domain.CloudflareAccess{}
-	json.Unmarshal([]byte(c.option.Access), access)
-
-	os.Setenv("CLOUDFLARE_DNS_API_TOKEN", access.DnsApiToken)
-	os.Setenv("CLOUDFLARE_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", c.option.Timeout))
-
-	provider, err := cf.NewDNSProvider()
+	dnsProvider, err := c.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
-	return apply(c.option, provider)
+	return apply(c.option, dnsProvider)
+}
+
+func (c *cloudflare) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.CloudflareAccess{}
+	json.Unmarshal([]byte(c.option.Access), access)
+
+	config := newDefaultConfig(cf.NewDefaultConfig)
+	config.AuthToken = access.DnsApiToken
+	config.ZoneToken = access.DnsApiToken
+	config.PropagationTimeout = getPropagationTimeout(c.option)
+
+	return cf.NewDNSProviderConfig(config)
 }
@@ -0,0 +1,104 @@
+package applicant
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+)
+
+// lego 的 DNS-01 质询通过进程级的 LEGO_DISABLE_CNAME_SUPPORT 环境变量及包级变量读取
+// CNAME 跟随开关与递归 DNS 服务器，无法按次申请传入。
+// 设置相同的申请可以并行，设置不同的申请需等待正在进行的申请全部结束后再切换，避免互相干扰。
+// 申请按到达顺序排队，排在后面的申请即使设置相同也不能越过等待切换的申请，避免其一直等待。
+var dns01Settings = newDns01SettingsGate()
+
+type dns01SettingsGate struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	holders int
+	// 排队序号，仅序号等于 serving 的申请可以尝试进入
+	next    uint64
+	serving uint64
+
+	disableFollowCNAME bool
+	nameservers        []string
+}
+
+func newDns01SettingsGate() *dns01SettingsGate {
+	g := &dns01SettingsGate{}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+// 以指定的设置占用 DNS-01 质询，返回的函数用于释放。
+//
+// 入参：
+//   - disableFollowCNAME: 是否禁止跟随 CNAME。
+//   - nameservers: 递归 DNS 服务器，为空时使用系统默认。
+//
+// 出参：
+//   - 释放函数。
+func (g *dns01SettingsGate) acquire(disableFollowCNAME bool, nameservers []string) func() {
+	if len(nameservers) == 0 {
+		nameservers = getDefaultNameservers()
+	} else {
+		nameservers = dns01.ParseNameservers(nameservers)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ticket := g.next
+	g.next++
+
+	for ticket != g.serving || (g.holders > 0 && !g.matches(disableFollowCNAME, nameservers)) {
+		g.cond.Wait()
+	}
+
+	if g.holders == 0 {
+		g.disableFollowCNAME = disableFollowCNAME
+		g.nameservers = nameservers
+
+		os.Setenv("LEGO_DISABLE_CNAME_SUPPORT", strconv.FormatBool(disableFollowCNAME))
+		// 该选项直接修改 lego 的包级变量，不依赖质询实例
+		dns01.AddRecursiveNameservers(nameservers)(nil)
+	}
+	g.holders++
+
+	// 轮到下一个排队的申请，设置相同时可以随即进入
+	g.serving++
+	g.cond.Broadcast()
+
+	return sync.OnceFunc(func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		g.holders--
+		if g.holders == 0 {
+			g.cond.Broadcast()
+		}
+	})
+}
+
+func (g *dns01SettingsGate) matches(disableFollowCNAME bool, nameservers []string) bool {
+	return g.disableFollowCNAME == disableFollowCNAME && slices.Equal(g.nameservers, nameservers)
+}
+
+// 与 lego 的默认值一致：优先使用系统配置的 DNS 服务器，否则使用 Google 公共 DNS。
+var getDefaultNameservers = sync.OnceValue(func() []string {
+	config, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil || len(config.Servers) == 0 {
+		return []string{"google-public-dns-a.google.com:53", "google-public-dns-b.google.com:53"}
+	}
+
+	return dns01.ParseNameservers(config.Servers)
+})
+
+func getPropagationTimeout(option *ApplyOption) time.Duration {
+	return time.Duration(option.Timeout) * time.Second
+}
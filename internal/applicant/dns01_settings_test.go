@@ -0,0 +1,144 @@
+package applicant
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"certimate/internal/domain"
+)
+
+func TestDNSProvidersInParallel(t *testing.T) {
+	const n = 8
+
+	servers := make([]*httptest.Server, n)
+	for i := range servers {
+		username := fmt.Sprintf("user-%d", i)
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u, _, _ := r.BasicAuth(); u != username {
+				t.Errorf("server %s received credentials of %s", username, u)
+			}
+		}))
+		defer servers[i].Close()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			access, _ := json.Marshal(&domain.HttpreqAccess{
+				Endpoint: servers[i].URL,
+				Mode:     "RAW",
+				Username: fmt.Sprintf("user-%d", i),
+				Password: "secret",
+			})
+			applicant := &httpReq{option: &ApplyOption{Access: string(access), Timeout: 60}}
+
+			provider, err := applicant.newDNSProvider()
+			if err != nil {
+				t.Errorf("failed to create provider: %v", err)
+				return
+			}
+
+			for j := 0; j < 10; j++ {
+				if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+					t.Errorf("failed to present: %v", err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDns01SettingsGate(t *testing.T) {
+	gate := newDns01SettingsGate()
+
+	var active [2]atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			disableFollowCNAME := i%2 == 0
+			idx := i % 2
+
+			release := gate.acquire(disableFollowCNAME, []string{"127.0.0.1"})
+			defer release()
+
+			active[idx].Add(1)
+			defer active[idx].Add(-1)
+
+			if active[1-idx].Load() != 0 {
+				t.Error("applications with different dns-01 settings are running at the same time")
+			}
+
+			if env := os.Getenv("LEGO_DISABLE_CNAME_SUPPORT"); env != strconv.FormatBool(disableFollowCNAME) {
+				t.Errorf("unexpected LEGO_DISABLE_CNAME_SUPPORT: %s", env)
+			}
+
+			time.Sleep(time.Millisecond)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDns01SettingsGateFairness(t *testing.T) {
+	gate := newDns01SettingsGate()
+
+	waitQueued := func(n uint64) {
+		for {
+			gate.mu.Lock()
+			queued := gate.next
+			gate.mu.Unlock()
+			if queued >= n {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	release := gate.acquire(false, []string{"127.0.0.1"})
+
+	// 设置不同的申请排队等待
+	switched := make(chan func())
+	go func() {
+		switched <- gate.acquire(true, []string{"127.0.0.1"})
+	}()
+	waitQueued(2)
+
+	// 之后到达的申请即使与当前设置相同，也要排在设置不同的申请之后
+	same := make(chan func())
+	go func() {
+		same <- gate.acquire(false, []string{"127.0.0.1"})
+	}()
+	waitQueued(3)
+
+	select {
+	case <-same:
+		t.Fatal("application with the same settings overtook a queued application")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+
+	releaseSwitched := <-switched
+	select {
+	case <-same:
+		t.Fatal("applications with different dns-01 settings are running at the same time")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	releaseSwitched()
+	(<-same)()
+}
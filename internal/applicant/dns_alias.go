@@ -0,0 +1,114 @@
+package applicant
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"certimate/internal/domain"
+)
+
+// 检查 DNS 别名配置，并通过申请配置中的 DNS 服务器确认 `_acme-challenge` 记录已 CNAME 到别名。
+//
+// 入参：
+//   - applyConfig: 申请配置。
+//   - domains: 以分号分隔的域名列表。
+//
+// 出参：
+//   - 检查结果，用于记录日志。
+//   - 错误。
+func CheckDnsAliases(applyConfig *domain.ApplyConfig, domains string) ([]string, error) {
+	if len(applyConfig.DnsAliases) == 0 {
+		return nil, nil
+	}
+
+	if applyConfig.GetChallengeType() != domain.ChallengeTypeDNS01 {
+		return nil, errors.New("dns alias is only supported for dns-01 challenge")
+	}
+
+	sans := make(map[string]bool)
+	for _, san := range strings.Split(domains, ";") {
+		sans[normalizeDnsRouteDomain(san)] = true
+	}
+
+	nameservers := getApplyNameservers(applyConfig.Nameservers)
+
+	info := make([]string, 0, len(applyConfig.DnsAliases))
+	seen := make(map[string]bool)
+	for _, alias := range applyConfig.DnsAliases {
+		name := normalizeDnsRouteDomain(alias.Domain)
+		if name == "" || alias.AliasDomain == "" || alias.Access == "" {
+			return info, fmt.Errorf("dns alias of %s is incomplete", alias.Domain)
+		}
+		if !sans[name] {
+			return info, fmt.Errorf("dns alias domain %s is not in the domain list", alias.Domain)
+		}
+		if seen[name] {
+			return info, fmt.Errorf("dns alias of %s is duplicated", alias.Domain)
+		}
+		seen[name] = true
+
+		fqdn := dns.Fqdn("_acme-challenge." + name)
+		target, err := lookupCNAME(nameservers, fqdn)
+		if err != nil {
+			return info, fmt.Errorf("failed to resolve cname of %s: %w", fqdn, err)
+		}
+
+		if !strings.EqualFold(target, dns.Fqdn(alias.AliasDomain)) {
+			return info, fmt.Errorf("%s should be a cname to %s, but got %q", fqdn, dns.Fqdn(alias.AliasDomain), target)
+		}
+
+		info = append(info, fmt.Sprintf("%s CNAME %s", fqdn, target))
+	}
+
+	return info, nil
+}
+
+// 查询 CNAME 记录的目标，使用第一个可用的 DNS 服务器，不存在 CNAME 记录时返回空字符串。
+func lookupCNAME(nameservers []string, fqdn string) (string, error) {
+	in, err := queryDNS(nameservers, fqdn, dns.TypeCNAME)
+	if err != nil {
+		return "", err
+	}
+
+	for _, rr := range in.Answer {
+		if cname, ok := rr.(*dns.CNAME); ok && strings.EqualFold(cname.Hdr.Name, fqdn) {
+			return cname.Target, nil
+		}
+	}
+
+	return "", nil
+}
+
+// 向第一个可用的 DNS 服务器发起递归查询，记录不存在（NXDOMAIN）不视为错误。
+func queryDNS(nameservers []string, fqdn string, qtype uint16) (*dns.Msg, error) {
+	client := &dns.Client{Timeout: 10 * time.Second}
+
+	var lastErr error
+	for _, ns := range nameservers {
+		msg := new(dns.Msg)
+		msg.SetQuestion(fqdn, qtype)
+
+		in, _, err := client.Exchange(msg, ns)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if in.Rcode != dns.RcodeSuccess && in.Rcode != dns.RcodeNameError {
+			lastErr = fmt.Errorf("unexpected response code %s from %s", dns.RcodeToString[in.Rcode], ns)
+			continue
+		}
+
+		return in, nil
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("no available nameservers")
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,259 @@
+package applicant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+)
+
+// 可以单独创建 DNS 提供商的申请器，用于按域名组合多个授权。
+type dnsProviderApplicant interface {
+	Applicant
+	newDNSProvider() (challenge.Provider, error)
+}
+
+func newDNSProviderApplicant(configType string, option *ApplyOption) (dnsProviderApplicant, error) {
+	applicant, err := newDNSApplicant(configType, option)
+	if err != nil {
+		return nil, err
+	}
+
+	// 手动验证需要替换 lego 的传播检查，无法与其他提供商组合
+	rs, ok := applicant.(dnsProviderApplicant)
+	if !ok {
+		return nil, fmt.Errorf("access type %s cannot be combined with other dns providers", configType)
+	}
+
+	return rs, nil
+}
+
+type dnsRoute struct {
+	// 规则描述，用于日志。
+	name      string
+	match     func(domain string) bool
+	applicant dnsProviderApplicant
+}
+
+// 按域名将 DNS-01 质询分派到不同授权的申请器，别名优先，其次按区域映射由具体到宽泛匹配，
+// 均未匹配的域名使用申请配置中的授权。
+type dnsRoutingApplicant struct {
+	option   *ApplyOption
+	fallback dnsProviderApplicant
+	routes   []*dnsRoute
+}
+
+func newDnsRoutingApplicant(applyConfig *domain.ApplyConfig, option *ApplyOption, configType string) (Applicant, error) {
+	fallback, err := newDNSProviderApplicant(configType, option)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]*dnsRoute, 0, len(applyConfig.DnsAliases)+len(applyConfig.DnsProviders))
+	for _, alias := range applyConfig.DnsAliases {
+		aliasDomain := normalizeDnsRouteDomain(alias.Domain)
+		applicant, err := newDnsRouteApplicant(alias.Access, option)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dns provider for alias of %s: %w", alias.Domain, err)
+		}
+
+		routes = append(routes, &dnsRoute{
+			name:      fmt.Sprintf("%s -> %s", alias.Domain, alias.AliasDomain),
+			match:     func(domain string) bool { return normalizeDnsRouteDomain(domain) == aliasDomain },
+			applicant: applicant,
+		})
+	}
+
+	providers := make([]domain.DnsProviderConfig, len(applyConfig.DnsProviders))
+	copy(providers, applyConfig.DnsProviders)
+	sort.SliceStable(providers, func(i, j int) bool {
+		return len(normalizeDnsRoutePattern(providers[i].Pattern)) > len(normalizeDnsRoutePattern(providers[j].Pattern))
+	})
+
+	for _, mapping := range providers {
+		if normalizeDnsRoutePattern(mapping.Pattern) == "" {
+			return nil, fmt.Errorf("dns provider pattern is empty")
+		}
+
+		applicant, err := newDnsRouteApplicant(mapping.Access, option)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dns provider for %s: %w", mapping.Pattern, err)
+		}
+
+		routes = append(routes, &dnsRoute{
+			name:      fmt.Sprintf("%s -> %s", mapping.Pattern, mapping.Access),
+			match:     newDnsPatternMatcher(mapping.Pattern),
+			applicant: applicant,
+		})
+	}
+
+	// 别名模式依赖 lego 跟随 CNAME 将 TXT 记录写入别名所在的区域
+	if len(applyConfig.DnsAliases) > 0 && option.DisableFollowCNAME {
+		option.DisableFollowCNAME = false
+		option.Logger.Logf("DNS 别名模式需要跟随 CNAME，本次申请已启用")
+	}
+
+	return &dnsRoutingApplicant{
+		option:   option,
+		fallback: fallback,
+		routes:   routes,
+	}, nil
+}
+
+func newDnsRouteApplicant(accessId string, option *ApplyOption) (dnsProviderApplicant, error) {
+	access, err := app.GetApp().Dao().FindRecordById("access", accessId)
+	if err != nil {
+		return nil, fmt.Errorf("access record not found: %w", err)
+	}
+
+	routeOption := *option
+	routeOption.Access = access.GetString("config")
+	routeOption.AccessId = access.Id
+
+	return newDNSProviderApplicant(access.GetString("configType"), &routeOption)
+}
+
+func (a *dnsRoutingApplicant) Apply() (*Certificate, error) {
+	provider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, provider)
+}
+
+func (a *dnsRoutingApplicant) newDNSProvider() (challenge.Provider, error) {
+	fallback, err := a.fallback.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]dnsProviderRoute, 0, len(a.routes))
+	for _, route := range a.routes {
+		provider, err := route.applicant.newDNSProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dns provider for %s: %w", route.name, err)
+		}
+
+		routes = append(routes, dnsProviderRoute{match: route.match, provider: provider})
+		a.option.Logger.Logf("DNS 验证规则: %s", route.name)
+	}
+
+	return newDnsRoutingProvider(fallback, routes), nil
+}
+
+type dnsProviderRoute struct {
+	match    func(domain string) bool
+	provider challenge.Provider
+}
+
+// 按域名将 Present/CleanUp 分派到对应的提供商，按顺序匹配，均未匹配时使用默认提供商。
+type dnsRoutingProvider struct {
+	fallback challenge.Provider
+	routes   []dnsProviderRoute
+}
+
+func newDnsRoutingProvider(fallback challenge.Provider, routes []dnsProviderRoute) challenge.Provider {
+	p := &dnsRoutingProvider{fallback: fallback, routes: routes}
+
+	// 任一提供商要求逐个完成质询时，组合后的提供商也需逐个完成
+	for _, provider := range p.providers() {
+		if _, ok := provider.(interface{ Sequential() time.Duration }); ok {
+			return &sequentialDnsRoutingProvider{p}
+		}
+	}
+
+	return p
+}
+
+func (p *dnsRoutingProvider) Present(domain, token, keyAuth string) error {
+	return p.route(domain).Present(domain, token, keyAuth)
+}
+
+func (p *dnsRoutingProvider) CleanUp(domain, token, keyAuth string) error {
+	return p.route(domain).CleanUp(domain, token, keyAuth)
+}
+
+// lego 对所有域名使用同一组超时时间，取各提供商中最长的超时时间及最短的轮询间隔。
+func (p *dnsRoutingProvider) Timeout() (time.Duration, time.Duration) {
+	var timeout, interval time.Duration
+	for _, provider := range p.providers() {
+		t, i := dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+		if provider, ok := provider.(challenge.ProviderTimeout); ok {
+			t, i = provider.Timeout()
+		}
+
+		timeout = max(timeout, t)
+		if interval == 0 || i < interval {
+			interval = i
+		}
+	}
+
+	return timeout, interval
+}
+
+func (p *dnsRoutingProvider) route(domain string) challenge.Provider {
+	for _, route := range p.routes {
+		if route.match(domain) {
+			return route.provider
+		}
+	}
+
+	return p.fallback
+}
+
+func (p *dnsRoutingProvider) providers() []challenge.Provider {
+	providers := []challenge.Provider{p.fallback}
+	for _, route := range p.routes {
+		providers = append(providers, route.provider)
+	}
+
+	return providers
+}
+
+type sequentialDnsRoutingProvider struct {
+	*dnsRoutingProvider
+}
+
+func (p *sequentialDnsRoutingProvider) Sequential() time.Duration {
+	var interval time.Duration
+	for _, provider := range p.providers() {
+		if provider, ok := provider.(interface{ Sequential() time.Duration }); ok {
+			interval = max(interval, provider.Sequential())
+		}
+	}
+
+	return interval
+}
+
+// 统一为小写且不含通配符前缀及结尾的点，通配符域名与其主域名使用同一条 TXT 记录。
+func normalizeDnsRouteDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	return strings.TrimPrefix(domain, "*.")
+}
+
+func normalizeDnsRoutePattern(pattern string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(pattern), "."))
+}
+
+// 区域匹配其自身及所有子域名；以 "*." 开头的模式仅匹配子域名。
+func newDnsPatternMatcher(pattern string) func(domain string) bool {
+	pattern = normalizeDnsRoutePattern(pattern)
+	subdomainOnly := strings.HasPrefix(pattern, "*.")
+	zone := strings.TrimPrefix(pattern, "*.")
+
+	return func(domain string) bool {
+		domain = normalizeDnsRouteDomain(domain)
+		if domain == zone {
+			return !subdomainOnly
+		}
+
+		return strings.HasSuffix(domain, "."+zone)
+	}
+}
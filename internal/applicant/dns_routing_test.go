@@ -0,0 +1,173 @@
+package applicant
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"certimate/internal/domain"
+)
+
+type testRecordingProvider struct {
+	name     string
+	timeout  time.Duration
+	interval time.Duration
+	presents *[]string
+}
+
+func (p *testRecordingProvider) Present(domain, token, keyAuth string) error {
+	*p.presents = append(*p.presents, p.name+":"+domain)
+	return nil
+}
+
+func (p *testRecordingProvider) CleanUp(domain, token, keyAuth string) error {
+	return nil
+}
+
+func (p *testRecordingProvider) Timeout() (time.Duration, time.Duration) {
+	return p.timeout, p.interval
+}
+
+type testSequentialProvider struct {
+	*testRecordingProvider
+}
+
+func (p *testSequentialProvider) Sequential() time.Duration {
+	return 30 * time.Second
+}
+
+func TestDnsRoutingProvider(t *testing.T) {
+	presents := make([]string, 0)
+	fallback := &testRecordingProvider{name: "fallback", timeout: time.Minute, interval: 5 * time.Second, presents: &presents}
+	alias := &testRecordingProvider{name: "alias", timeout: 3 * time.Minute, interval: 2 * time.Second, presents: &presents}
+
+	provider := newDnsRoutingProvider(fallback, []dnsProviderRoute{
+		{match: func(domain string) bool { return normalizeDnsRouteDomain(domain) == "example.com" }, provider: alias},
+	})
+
+	for _, domain := range []string{"example.com", "www.example.com", "EXAMPLE.COM."} {
+		provider.Present(domain, "token", "keyAuth")
+	}
+
+	want := []string{"alias:example.com", "fallback:www.example.com", "alias:EXAMPLE.COM."}
+	for i := range want {
+		if presents[i] != want[i] {
+			t.Errorf("unexpected route at %d: %s", i, presents[i])
+		}
+	}
+
+	timeout, interval := provider.(*dnsRoutingProvider).Timeout()
+	if timeout != 3*time.Minute || interval != 2*time.Second {
+		t.Errorf("unexpected timeout: %v, %v", timeout, interval)
+	}
+
+	if _, ok := provider.(interface{ Sequential() time.Duration }); ok {
+		t.Error("unexpected sequential provider")
+	}
+}
+
+func TestDnsRoutingProviderSequential(t *testing.T) {
+	presents := make([]string, 0)
+	fallback := &testRecordingProvider{name: "fallback", presents: &presents}
+	sequential := &testSequentialProvider{&testRecordingProvider{name: "sequential", presents: &presents}}
+
+	provider := newDnsRoutingProvider(fallback, []dnsProviderRoute{
+		{match: func(string) bool { return true }, provider: sequential},
+	})
+
+	p, ok := provider.(interface{ Sequential() time.Duration })
+	if !ok {
+		t.Fatal("expected sequential provider")
+	}
+	if p.Sequential() != 30*time.Second {
+		t.Errorf("unexpected sequential interval: %v", p.Sequential())
+	}
+}
+
+func startTestCNAMEServer(t *testing.T, records map[string]string) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			if target, ok := records[r.Question[0].Name]; ok {
+				m.Answer = append(m.Answer, &dns.CNAME{
+					Hdr:    dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+					Target: target,
+				})
+			} else {
+				m.Rcode = dns.RcodeNameError
+			}
+			w.WriteMsg(m)
+		}),
+	}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+
+	return conn.LocalAddr().String()
+}
+
+func TestCheckDnsAliases(t *testing.T) {
+	nameserver := startTestCNAMEServer(t, map[string]string{
+		"_acme-challenge.example.com.": "example-com.acme.example.net.",
+	})
+
+	cases := []struct {
+		name    string
+		domains string
+		alias   domain.DnsAliasConfig
+		wantErr bool
+	}{
+		{"Matched", "example.com;*.example.com", domain.DnsAliasConfig{Domain: "*.example.com", AliasDomain: "example-com.acme.example.net", Access: "a"}, false},
+		{"Mismatched", "example.com", domain.DnsAliasConfig{Domain: "example.com", AliasDomain: "other.acme.example.net", Access: "a"}, true},
+		{"Missing", "example.org", domain.DnsAliasConfig{Domain: "example.org", AliasDomain: "example-org.acme.example.net", Access: "a"}, true},
+		{"NotInDomains", "example.com", domain.DnsAliasConfig{Domain: "www.example.com", AliasDomain: "example-com.acme.example.net", Access: "a"}, true},
+		{"NoAccess", "example.com", domain.DnsAliasConfig{Domain: "example.com", AliasDomain: "example-com.acme.example.net"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			applyConfig := &domain.ApplyConfig{
+				Nameservers: nameserver,
+				DnsAliases:  []domain.DnsAliasConfig{c.alias},
+			}
+
+			_, err := CheckDnsAliases(applyConfig, c.domains)
+			if (err != nil) != c.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDnsPatternMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		domain  string
+		want    bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "*.example.com", true},
+		{"example.com", "a.b.EXAMPLE.com.", true},
+		{"example.com", "notexample.com", false},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "www.example.com", true},
+		{"b.cn", "example.com", false},
+	}
+
+	for _, tt := range tests {
+		if got := newDnsPatternMatcher(tt.pattern)(tt.domain); got != tt.want {
+			t.Errorf("pattern %s, domain %s: got %v, want %v", tt.pattern, tt.domain, got, tt.want)
+		}
+	}
+}
@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	godaddyProvider "github.com/go-acme/lego/v4/providers/dns/godaddy"
 
 	"certimate/internal/domain"
@@ -21,17 +20,22 @@ func NewGodaddy(option *ApplyOption) Applicant {
 }
 
 func (a *godaddy) Apply() (*Certificate, error) {
-	access := &domain.GodaddyAccess{}
-	json.Unmarshal([]byte(a.option.Access), access)
-
-	os.Setenv("GODADDY_API_KEY", access.ApiKey)
-	os.Setenv("GODADDY_API_SECRET", access.ApiSecret)
-	os.Setenv("GODADDY_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-
-	dnsProvider, err := godaddyProvider.NewDNSProvider()
+	dnsProvider, err := a.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(a.option, dnsProvider)
 }
+
+func (a *godaddy) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.GodaddyAccess{}
+	json.Unmarshal([]byte(a.option.Access), access)
+
+	config := newDefaultConfig(godaddyProvider.NewDefaultConfig)
+	config.APIKey = access.ApiKey
+	config.APISecret = access.ApiSecret
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return godaddyProvider.NewDNSProviderConfig(config)
+}
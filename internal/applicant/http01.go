@@ -0,0 +1,54 @@
+package applicant
+
+import (
+	"fmt"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/lego"
+
+	"certimate/internal/domain"
+)
+
+const defaultHttpChallengePort = "80"
+
+type httpChallenge struct {
+	option *ApplyOption
+}
+
+func NewHttpChallenge(option *ApplyOption) Applicant {
+	return &httpChallenge{
+		option: option,
+	}
+}
+
+func (h *httpChallenge) Apply() (*Certificate, error) {
+	config := h.option.HttpChallenge
+	if config == nil {
+		config = &domain.HttpChallengeConfig{}
+	}
+
+	var provider challenge.Provider
+	switch config.Solver {
+	case "", domain.HttpChallengeSolverStandalone:
+		port := config.Port
+		if port == "" {
+			port = defaultHttpChallengePort
+		}
+		provider = http01.NewProviderServer(config.Interface, port)
+	case domain.HttpChallengeSolverRouter:
+		provider = &httpChallengeRouterProvider{}
+	case domain.HttpChallengeSolverWebroot:
+		webrootProvider, err := newHttpChallengeWebrootProvider(config)
+		if err != nil {
+			return nil, err
+		}
+		provider = webrootProvider
+	default:
+		return nil, fmt.Errorf("unsupported http challenge solver: %s", config.Solver)
+	}
+
+	return applyWithChallenge(h.option, func(client *lego.Client) error {
+		return client.Challenge.SetHTTP01Provider(provider)
+	})
+}
@@ -0,0 +1,39 @@
+package applicant
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// 等待 CA 验证的 HTTP-01 质询，键为 token，值为 keyAuth。
+var httpChallengeTokens sync.Map
+
+// 将 HTTP-01 质询交由 Certimate 自身的 HTTP 服务响应，
+// 适用于 80 端口已经由 Certimate 或其前置反向代理占用的场景。
+type httpChallengeRouterProvider struct{}
+
+func (p *httpChallengeRouterProvider) Present(domain, token, keyAuth string) error {
+	httpChallengeTokens.Store(token, keyAuth)
+	return nil
+}
+
+func (p *httpChallengeRouterProvider) CleanUp(domain, token, keyAuth string) error {
+	httpChallengeTokens.Delete(token)
+	return nil
+}
+
+type HttpChallengeService struct{}
+
+func NewHttpChallengeService() *HttpChallengeService {
+	return &HttpChallengeService{}
+}
+
+func (s *HttpChallengeService) GetKeyAuthorization(ctx context.Context, token string) (string, error) {
+	keyAuth, ok := httpChallengeTokens.Load(token)
+	if !ok {
+		return "", errors.New("http challenge token not found")
+	}
+
+	return keyAuth.(string), nil
+}
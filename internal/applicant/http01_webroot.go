@@ -0,0 +1,202 @@
+package applicant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	xerrors "github.com/pkg/errors"
+	"github.com/tencentyun/cos-go-sdk-v5"
+
+	"certimate/internal/domain"
+	sshutil "certimate/internal/pkg/utils/ssh"
+	"certimate/internal/utils/app"
+)
+
+// 根据 webroot 模式所选的授权记录创建对应的 HTTP-01 质询提供者，
+// 将 token 写入远程主机的网站根目录或对象存储中，无需在 Certimate 所在主机上开放 80 端口。
+func newHttpChallengeWebrootProvider(config *domain.HttpChallengeConfig) (challenge.Provider, error) {
+	if config.Access == "" {
+		return nil, errors.New("http challenge webroot access is required")
+	}
+
+	access, err := app.GetApp().Dao().FindRecordById("access", config.Access)
+	if err != nil {
+		return nil, fmt.Errorf("access record not found: %w", err)
+	}
+
+	switch access.GetString("configType") {
+	case configTypeSSH:
+		sshAccess := &domain.SSHAccess{}
+		if err := json.Unmarshal([]byte(access.GetString("config")), sshAccess); err != nil {
+			return nil, err
+		}
+
+		return &httpChallengeSshProvider{access: sshAccess, webroot: config.Webroot}, nil
+
+	case configTypeAliyun:
+		aliyunAccess := &domain.AliyunAccess{}
+		if err := json.Unmarshal([]byte(access.GetString("config")), aliyunAccess); err != nil {
+			return nil, err
+		}
+
+		if config.Bucket == "" {
+			return nil, errors.New("http challenge webroot bucket is required")
+		}
+
+		endpoint := "oss.aliyuncs.com"
+		if config.Region != "" {
+			endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", config.Region)
+		}
+
+		client, err := oss.New(endpoint, aliyunAccess.AccessKeyId, aliyunAccess.AccessKeySecret)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to create oss client")
+		}
+
+		bucket, err := client.Bucket(config.Bucket)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to get oss bucket")
+		}
+
+		return &httpChallengeOssProvider{bucket: bucket, webroot: config.Webroot}, nil
+
+	case configTypeTencent:
+		tencentAccess := &domain.TencentAccess{}
+		if err := json.Unmarshal([]byte(access.GetString("config")), tencentAccess); err != nil {
+			return nil, err
+		}
+
+		if config.Bucket == "" || config.Region == "" {
+			return nil, errors.New("http challenge webroot bucket and region are required")
+		}
+
+		bucketUrl, err := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", config.Bucket, config.Region))
+		if err != nil {
+			return nil, err
+		}
+
+		client := cos.NewClient(&cos.BaseURL{BucketURL: bucketUrl}, &http.Client{
+			Transport: &cos.AuthorizationTransport{
+				SecretID:  tencentAccess.SecretId,
+				SecretKey: tencentAccess.SecretKey,
+			},
+		})
+
+		return &httpChallengeCosProvider{client: client, webroot: config.Webroot}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported http challenge webroot access type: %s", access.GetString("configType"))
+	}
+}
+
+// 获取 token 文件在 webroot 下的路径。
+func getHttpChallengeWebrootPath(webroot, token string) string {
+	return path.Join(webroot, http01.ChallengePath(token))
+}
+
+// 获取 token 文件在对象存储中的对象键。
+func getHttpChallengeObjectKey(webroot, token string) string {
+	return strings.TrimPrefix(getHttpChallengeWebrootPath(webroot, token), "/")
+}
+
+type httpChallengeSshProvider struct {
+	access  *domain.SSHAccess
+	webroot string
+}
+
+func (p *httpChallengeSshProvider) Present(domain, token, keyAuth string) error {
+	port, _ := strconv.ParseInt(p.access.Port, 10, 32)
+	client, err := sshutil.NewClient(p.access.Host, int32(port), p.access.Username, p.access.Password, p.access.Key, p.access.KeyPassphrase)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer client.Close()
+
+	if err := sshutil.WriteSftpFileString(client, getHttpChallengeWebrootPath(p.webroot, token), keyAuth); err != nil {
+		return xerrors.Wrap(err, "failed to upload http challenge token")
+	}
+
+	return nil
+}
+
+func (p *httpChallengeSshProvider) CleanUp(domain, token, keyAuth string) error {
+	port, _ := strconv.ParseInt(p.access.Port, 10, 32)
+	client, err := sshutil.NewClient(p.access.Host, int32(port), p.access.Username, p.access.Password, p.access.Key, p.access.KeyPassphrase)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer client.Close()
+
+	if err := sshutil.RemoveSftpFile(client, getHttpChallengeWebrootPath(p.webroot, token)); err != nil {
+		return xerrors.Wrap(err, "failed to remove http challenge token")
+	}
+
+	return nil
+}
+
+type httpChallengeOssProvider struct {
+	bucket  *oss.Bucket
+	webroot string
+}
+
+func (p *httpChallengeOssProvider) Present(domain, token, keyAuth string) error {
+	err := p.bucket.PutObject(
+		getHttpChallengeObjectKey(p.webroot, token),
+		strings.NewReader(keyAuth),
+		oss.ContentType("text/plain"),
+		oss.ObjectACL(oss.ACLPublicRead),
+	)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'oss.PutObject'")
+	}
+
+	return nil
+}
+
+func (p *httpChallengeOssProvider) CleanUp(domain, token, keyAuth string) error {
+	if err := p.bucket.DeleteObject(getHttpChallengeObjectKey(p.webroot, token)); err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'oss.DeleteObject'")
+	}
+
+	return nil
+}
+
+type httpChallengeCosProvider struct {
+	client  *cos.Client
+	webroot string
+}
+
+func (p *httpChallengeCosProvider) Present(domain, token, keyAuth string) error {
+	_, err := p.client.Object.Put(
+		context.Background(),
+		getHttpChallengeObjectKey(p.webroot, token),
+		strings.NewReader(keyAuth),
+		&cos.ObjectPutOptions{
+			ACLHeaderOptions:       &cos.ACLHeaderOptions{XCosACL: "public-read"},
+			ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{ContentType: "text/plain"},
+		},
+	)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'cos.Object.Put'")
+	}
+
+	return nil
+}
+
+func (p *httpChallengeCosProvider) CleanUp(domain, token, keyAuth string) error {
+	if _, err := p.client.Object.Delete(context.Background(), getHttpChallengeObjectKey(p.webroot, token)); err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'cos.Object.Delete'")
+	}
+
+	return nil
+}
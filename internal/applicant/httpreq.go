@@ -3,8 +3,9 @@ package applicant
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"net/url"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/providers/dns/httpreq"
 
 	"certimate/internal/domain"
@@ -21,18 +22,29 @@ func NewHttpreq(option *ApplyOption) Applicant {
 }
 
 func (a *httpReq) Apply() (*Certificate, error) {
+	dnsProvider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, dnsProvider)
+}
+
+func (a *httpReq) newDNSProvider() (challenge.Provider, error) {
 	access := &domain.HttpreqAccess{}
 	json.Unmarshal([]byte(a.option.Access), access)
 
-	os.Setenv("HTTPREQ_ENDPOINT", access.Endpoint)
-	os.Setenv("HTTPREQ_MODE", access.Mode)
-	os.Setenv("HTTPREQ_USERNAME", access.Username)
-	os.Setenv("HTTPREQ_PASSWORD", access.Password)
-	os.Setenv("HTTPREQ_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-	dnsProvider, err := httpreq.NewDNSProvider()
+	endpoint, err := url.Parse(access.Endpoint)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("httpreq: %w", err)
 	}
 
-	return apply(a.option, dnsProvider)
+	config := newDefaultConfig(httpreq.NewDefaultConfig)
+	config.Endpoint = endpoint
+	config.Mode = access.Mode
+	config.Username = access.Username
+	config.Password = access.Password
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return httpreq.NewDNSProviderConfig(config)
 }
@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	huaweicloudProvider "github.com/go-acme/lego/v4/providers/dns/huaweicloud"
 
 	"certimate/internal/domain"
@@ -21,6 +20,15 @@ func NewHuaweiCloud(option *ApplyOption) Applicant {
 }
 
 func (t *huaweicloud) Apply() (*Certificate, error) {
+	dnsProvider, err := t.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(t.option, dnsProvider)
+}
+
+func (t *huaweicloud) newDNSProvider() (challenge.Provider, error) {
 	access := &domain.HuaweiCloudAccess{}
 	json.Unmarshal([]byte(t.option.Access), access)
 
@@ -29,15 +37,11 @@ func (t *huaweicloud) Apply() (*Certificate, error) {
 		region = "cn-north-1"
 	}
 
-	os.Setenv("HUAWEICLOUD_REGION", region) // 华为云的 SDK 要求必须传一个区域，实际上 DNS-01 流程里用不到，但不传会报错
-	os.Setenv("HUAWEICLOUD_ACCESS_KEY_ID", access.AccessKeyId)
-	os.Setenv("HUAWEICLOUD_SECRET_ACCESS_KEY", access.SecretAccessKey)
-	os.Setenv("HUAWEICLOUD_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", t.option.Timeout))
-
-	dnsProvider, err := huaweicloudProvider.NewDNSProvider()
-	if err != nil {
-		return nil, err
-	}
+	config := newDefaultConfig(huaweicloudProvider.NewDefaultConfig)
+	config.Region = region // 华为云的 SDK 要求必须传一个区域，实际上 DNS-01 流程里用不到，但不传会报错
+	config.AccessKeyID = access.AccessKeyId
+	config.SecretAccessKey = access.SecretAccessKey
+	config.PropagationTimeout = getPropagationTimeout(t.option)
 
-	return apply(t.option, dnsProvider)
+	return huaweicloudProvider.NewDNSProviderConfig(config)
 }
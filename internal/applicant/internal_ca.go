@@ -0,0 +1,103 @@
+package applicant
+
+import (
+	"crypto"
+	"crypto/x509/pkix"
+	"strings"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+
+	"certimate/internal/ca"
+	"certimate/internal/domain"
+)
+
+type internalCA struct {
+	option *ApplyOption
+}
+
+func NewInternalCA(option *ApplyOption) Applicant {
+	return &internalCA{
+		option: option,
+	}
+}
+
+func (i *internalCA) Apply() (*Certificate, error) {
+	authority, err := ca.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var privateKeyPem, csrPem string
+	var publicKey crypto.PublicKey
+	switch {
+	case i.option.Csr != "":
+		// 使用上传的 CSR 签发时，Certimate 不持有私钥
+		csr, err := certcrypto.PemDecodeTox509CSR([]byte(i.option.Csr))
+		if err != nil {
+			return nil, err
+		}
+		publicKey = csr.PublicKey
+		csrPem = i.option.Csr
+
+	default:
+		var privateKey crypto.PrivateKey
+		if i.option.PrivateKey != "" {
+			privateKey, err = certcrypto.ParsePEMPrivateKey([]byte(i.option.PrivateKey))
+		} else {
+			privateKey, err = certcrypto.GeneratePrivateKey(parseKeyAlgorithm(i.option.KeyAlgorithm))
+		}
+		if err != nil {
+			return nil, err
+		}
+		publicKey = privateKey.(crypto.Signer).Public()
+		privateKeyPem = string(certcrypto.PEMEncode(privateKey))
+	}
+
+	sans := strings.Split(i.option.Domain, ";")
+
+	config := i.option.InternalCA
+	if config == nil {
+		config = &domain.InternalCAConfig{}
+	}
+
+	subject := pkix.Name{CommonName: config.CommonName}
+	if subject.CommonName == "" {
+		subject.CommonName = sans[0]
+	}
+	if config.Organization != "" {
+		subject.Organization = []string{config.Organization}
+	}
+	if config.OrganizationalUnit != "" {
+		subject.OrganizationalUnit = []string{config.OrganizationalUnit}
+	}
+	if config.Country != "" {
+		subject.Country = []string{config.Country}
+	}
+	if config.Province != "" {
+		subject.Province = []string{config.Province}
+	}
+	if config.Locality != "" {
+		subject.Locality = []string{config.Locality}
+	}
+
+	i.option.Logger.Logf("使用内置 CA 签发证书")
+
+	certPem, err := authority.Issue(&ca.IssueRequest{
+		Subject:      subject,
+		SANs:         sans,
+		ValidityDays: config.ValidityDays,
+		PublicKey:    publicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{
+		PrivateKey:        privateKeyPem,
+		Certificate:       certPem + authority.IntermediateCertificate,
+		IssuerCertificate: authority.IntermediateCertificate,
+		Csr:               csrPem,
+		Ca:                sslProviderInternal,
+		PrimaryCa:         sslProviderInternal,
+	}, nil
+}
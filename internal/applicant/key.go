@@ -0,0 +1,120 @@
+package applicant
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"golang.org/x/exp/slices"
+
+	"certimate/internal/domain"
+)
+
+// 获取可复用的私钥。
+// 仅当已保存的私钥与申请配置中的数字证书算法一致时才复用，否则返回空字符串，由 lego 生成新的私钥。
+//
+// 入参：
+//   - privateKey: 域名记录中保存的 PEM 格式私钥。
+//   - keyAlgorithm: 申请配置中的数字证书算法。
+//
+// 出参：
+//   - 可复用的私钥。
+//   - 无法复用的原因。
+func getReusablePrivateKey(privateKey string, keyAlgorithm string) (string, string) {
+	if privateKey == "" {
+		return "", "没有可复用的私钥"
+	}
+
+	key, err := certcrypto.ParsePEMPrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Sprintf("解析已有私钥失败: %v", err)
+	}
+
+	if keyAlgorithm == "" {
+		keyAlgorithm = "RSA2048"
+	}
+
+	var algorithm string
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		algorithm = fmt.Sprintf("RSA%d", k.N.BitLen())
+	case *ecdsa.PrivateKey:
+		algorithm = fmt.Sprintf("EC%d", k.Curve.Params().BitSize)
+	}
+
+	if algorithm != keyAlgorithm {
+		return "", fmt.Sprintf("已有私钥算法 %s 与配置的 %s 不一致", algorithm, keyAlgorithm)
+	}
+
+	return privateKey, ""
+}
+
+// 校验上传的 CSR，CSR 中必须包含域名记录中的所有域名。
+//
+// 入参：
+//   - csrPem: PEM 格式的 CSR。
+//   - domains: 域名记录中的域名列表。
+//
+// 出参：
+//   - 错误。
+func checkCsr(csrPem string, domains []string) error {
+	if csrPem == "" {
+		return errors.New("csr is empty")
+	}
+
+	csr, err := certcrypto.PemDecodeTox509CSR([]byte(csrPem))
+	if err != nil {
+		return fmt.Errorf("failed to parse csr: %w", err)
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return fmt.Errorf("invalid csr signature: %w", err)
+	}
+
+	csrDomains := getCsrSANs(csr)
+	for _, d := range domains {
+		if !slices.Contains(csrDomains, d) {
+			return fmt.Errorf("csr does not contain domain %s", d)
+		}
+	}
+
+	return nil
+}
+
+// 根据申请配置中的私钥模式设置申请选项：复用已有私钥，或使用上传的 CSR 签发。
+func setKeyModeOption(option *ApplyOption, applyConfig *domain.ApplyConfig, privateKey string) error {
+	switch applyConfig.GetKeyMode() {
+	case domain.KeyModeReuse:
+		key, reason := getReusablePrivateKey(privateKey, applyConfig.KeyAlgorithm)
+		if key == "" {
+			option.Logger.Logf("不复用私钥: %s", reason)
+		}
+		option.PrivateKey = key
+	case domain.KeyModeCSR:
+		if err := checkCsr(applyConfig.Csr, strings.Split(option.Domain, ";")); err != nil {
+			return err
+		}
+		option.Csr = applyConfig.Csr
+	}
+
+	return nil
+}
+
+// 获取 CSR 中的域名及 IP 地址，通用名称不在 SAN 中时排在最前。
+func getCsrSANs(csr *x509.CertificateRequest) []string {
+	sans := make([]string, 0, len(csr.DNSNames)+len(csr.IPAddresses)+1)
+	sans = append(sans, csr.DNSNames...)
+	for _, ip := range csr.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	if cn := csr.Subject.CommonName; cn != "" && !slices.Contains(sans, cn) {
+		sans = append([]string{cn}, sans...)
+	}
+
+	return sans
+}
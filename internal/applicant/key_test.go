@@ -0,0 +1,49 @@
+package applicant
+
+import (
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+)
+
+func TestGetReusablePrivateKey(t *testing.T) {
+	privateKey, err := certcrypto.GeneratePrivateKey(certcrypto.EC256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyPem := string(certcrypto.PEMEncode(privateKey))
+
+	if key, _ := getReusablePrivateKey(privateKeyPem, "EC256"); key != privateKeyPem {
+		t.Errorf("expected private key to be reused")
+	}
+
+	if key, _ := getReusablePrivateKey(privateKeyPem, "RSA2048"); key != "" {
+		t.Errorf("expected private key with different algorithm not to be reused")
+	}
+
+	if key, _ := getReusablePrivateKey("", "EC256"); key != "" {
+		t.Errorf("expected empty private key not to be reused")
+	}
+}
+
+func TestCheckCsr(t *testing.T) {
+	privateKey, err := certcrypto.GeneratePrivateKey(certcrypto.EC256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr, err := certcrypto.GenerateCSR(privateKey, "example.com", []string{"www.example.com"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}))
+
+	if err := checkCsr(csrPem, []string{"example.com", "www.example.com"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := checkCsr(csrPem, []string{"example.com", "api.example.com"}); err == nil {
+		t.Errorf("expected error for domain not in csr")
+	}
+}
@@ -0,0 +1,297 @@
+package applicant
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns"
+
+	"certimate/internal/domain"
+)
+
+//go:generate go run lego_providers_gen.go
+
+// 部分 lego DNS 提供商只能通过环境变量按名称创建。
+// 创建时临时写入选项并在创建后立即恢复，期间持有写锁；读取 lego 默认配置时持有读锁，
+// 确保选项只作用于本次创建，不会被其他申请读到。
+var legoEnvMu sync.RWMutex
+
+type legoApplicant struct {
+	option *ApplyOption
+}
+
+func NewLego(option *ApplyOption) Applicant {
+	return &legoApplicant{
+		option: option,
+	}
+}
+
+func (a *legoApplicant) Apply() (*Certificate, error) {
+	dnsProvider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, dnsProvider)
+}
+
+func (a *legoApplicant) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.LegoAccess{}
+	if err := json.Unmarshal([]byte(a.option.Access), access); err != nil {
+		return nil, err
+	}
+
+	if access.Provider == "" {
+		return nil, errors.New("lego dns provider is empty")
+	}
+
+	// manual 需要在终端中交互，不适用于后台申请
+	if access.Provider == "manual" {
+		return nil, errors.New("lego dns provider manual is not supported")
+	}
+
+	// 只接受该提供商文档中列出的选项，避免写入 PATH、HTTP_PROXY 等影响整个进程的环境变量
+	documented, ok := legoProviderOptions[access.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported lego dns provider: %s", access.Provider)
+	}
+	for key := range access.Options {
+		if !slices.Contains(documented, key) {
+			return nil, fmt.Errorf("invalid option %s for lego dns provider %s", key, access.Provider)
+		}
+	}
+
+	var provider challenge.Provider
+	var err error
+	if config, ok := legoProviderConfigs[access.Provider]; ok && config.supports(access.Options) {
+		provider, err = config.newDNSProvider(access.Options)
+	} else {
+		provider, err = newLegoDNSProviderByName(access.Provider, access.Options)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return withPropagationTimeout(provider, getPropagationTimeout(a.option)), nil
+}
+
+// 通过环境变量创建提供商，仅用于选项无法直接对应到配置字段的提供商。
+func newLegoDNSProviderByName(name string, options map[string]string) (challenge.Provider, error) {
+	legoEnvMu.Lock()
+	defer legoEnvMu.Unlock()
+
+	for key, value := range options {
+		if previous, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, previous)
+		} else {
+			defer os.Unsetenv(key)
+		}
+
+		os.Setenv(key, value)
+	}
+
+	return dns.NewDNSChallengeProviderByName(name)
+}
+
+type legoOptionKind int
+
+const (
+	legoOptionString legoOptionKind = iota
+	legoOptionInt
+	legoOptionBool
+	legoOptionSecond
+)
+
+// lego 提供商选项对应的配置字段。
+type legoConfigField struct {
+	// 字段路径，如 "APIKey"、"HTTPClient.Timeout"。
+	path string
+	kind legoOptionKind
+}
+
+type legoProviderBuilder struct {
+	newConfig   func() any
+	newProvider func(config any) (challenge.Provider, error)
+}
+
+func newLegoProviderBuilder[C any, P challenge.Provider](newConfig func() *C, newProvider func(*C) (P, error)) legoProviderBuilder {
+	return legoProviderBuilder{
+		newConfig: func() any {
+			return newDefaultConfig(newConfig)
+		},
+		newProvider: func(config any) (challenge.Provider, error) {
+			provider, err := newDNSProviderConfig(newProvider, config.(*C))
+			if err != nil {
+				return nil, err
+			}
+
+			return provider, nil
+		},
+	}
+}
+
+// 可以直接通过配置创建的 lego 提供商，lego 从环境变量读取的选项均有对应的配置字段，见 lego_providers.go。
+type legoProviderConfig struct {
+	builder legoProviderBuilder
+	// 必填的选项，与 lego 从环境变量读取时的校验一致。
+	required []string
+	fields   map[string]legoConfigField
+}
+
+func (c *legoProviderConfig) supports(options map[string]string) bool {
+	for key := range options {
+		if _, ok := c.fields[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *legoProviderConfig) newDNSProvider(options map[string]string) (challenge.Provider, error) {
+	missing := make([]string, 0)
+	for _, key := range c.required {
+		if options[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("some credentials information are missing: %s", strings.Join(missing, ","))
+	}
+
+	config := c.builder.newConfig()
+	for key, value := range options {
+		// 与 lego 读取环境变量时一致，空值使用默认配置
+		if value == "" {
+			continue
+		}
+
+		if err := setLegoConfigField(config, c.fields[key], value); err != nil {
+			return nil, fmt.Errorf("invalid lego dns provider option %s: %w", key, err)
+		}
+	}
+
+	return c.builder.newProvider(config)
+}
+
+func setLegoConfigField(config any, field legoConfigField, value string) error {
+	v := reflect.ValueOf(config)
+	for _, name := range strings.Split(field.path, ".") {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+
+		v = v.FieldByName(name)
+		if !v.IsValid() || !v.CanSet() {
+			return fmt.Errorf("unknown config field %s", field.path)
+		}
+	}
+
+	switch field.kind {
+	case legoOptionString:
+		if v.Kind() != reflect.String {
+			return fmt.Errorf("config field %s is not a string", field.path)
+		}
+		v.SetString(value)
+
+	case legoOptionInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		if !v.CanInt() {
+			return fmt.Errorf("config field %s is not an integer", field.path)
+		}
+		v.SetInt(int64(n))
+
+	case legoOptionBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		if v.Kind() != reflect.Bool {
+			return fmt.Errorf("config field %s is not a boolean", field.path)
+		}
+		v.SetBool(b)
+
+	case legoOptionSecond:
+		d, err := env.ParseSecond(value)
+		if err != nil {
+			return err
+		}
+		if v.Type() != reflect.TypeOf(time.Duration(0)) {
+			return fmt.Errorf("config field %s is not a duration", field.path)
+		}
+		v.SetInt(int64(d))
+	}
+
+	return nil
+}
+
+// 读取 lego 提供商的默认配置，默认配置中的超时、TTL 等会从环境变量读取。
+func newDefaultConfig[T any](fn func() T) T {
+	legoEnvMu.RLock()
+	defer legoEnvMu.RUnlock()
+
+	return fn()
+}
+
+// 使用配置创建 lego 提供商，用于创建时仍会读取环境变量的提供商（如 route53 读取 AWS_* 凭据链）。
+func newDNSProviderConfig[C any, P any](fn func(C) (P, error), config C) (P, error) {
+	legoEnvMu.RLock()
+	defer legoEnvMu.RUnlock()
+
+	return fn(config)
+}
+
+// 使用申请配置中的超时时间覆盖提供商自身的传播超时时间。
+func withPropagationTimeout(provider challenge.Provider, timeout time.Duration) challenge.Provider {
+	if timeout <= 0 {
+		return provider
+	}
+
+	p := &timeoutProvider{Provider: provider, timeout: timeout}
+
+	// lego 根据该接口判断是否需要逐个完成质询，包装后需保留
+	if _, ok := provider.(interface{ Sequential() time.Duration }); ok {
+		return &sequentialTimeoutProvider{p}
+	}
+
+	return p
+}
+
+type timeoutProvider struct {
+	challenge.Provider
+	timeout time.Duration
+}
+
+func (p *timeoutProvider) Timeout() (time.Duration, time.Duration) {
+	interval := dns01.DefaultPollingInterval
+	if provider, ok := p.Provider.(challenge.ProviderTimeout); ok {
+		_, interval = provider.Timeout()
+	}
+
+	return p.timeout, interval
+}
+
+type sequentialTimeoutProvider struct {
+	*timeoutProvider
+}
+
+func (p *sequentialTimeoutProvider) Sequential() time.Duration {
+	return p.Provider.(interface{ Sequential() time.Duration }).Sequential()
+}
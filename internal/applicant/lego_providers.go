@@ -0,0 +1,1560 @@
+// Code generated by lego_providers_gen.go; DO NOT EDIT.
+
+package applicant
+
+import (
+	legoAllinkl "github.com/go-acme/lego/v4/providers/dns/allinkl"
+	legoArvancloud "github.com/go-acme/lego/v4/providers/dns/arvancloud"
+	legoAuroradns "github.com/go-acme/lego/v4/providers/dns/auroradns"
+	legoBindman "github.com/go-acme/lego/v4/providers/dns/bindman"
+	legoBluecat "github.com/go-acme/lego/v4/providers/dns/bluecat"
+	legoBrandit "github.com/go-acme/lego/v4/providers/dns/brandit"
+	legoBunny "github.com/go-acme/lego/v4/providers/dns/bunny"
+	legoCivo "github.com/go-acme/lego/v4/providers/dns/civo"
+	legoClouddns "github.com/go-acme/lego/v4/providers/dns/clouddns"
+	legoCloudru "github.com/go-acme/lego/v4/providers/dns/cloudru"
+	legoConoha "github.com/go-acme/lego/v4/providers/dns/conoha"
+	legoConstellix "github.com/go-acme/lego/v4/providers/dns/constellix"
+	legoCorenetworks "github.com/go-acme/lego/v4/providers/dns/corenetworks"
+	legoCpanel "github.com/go-acme/lego/v4/providers/dns/cpanel"
+	legoDerak "github.com/go-acme/lego/v4/providers/dns/derak"
+	legoDesec "github.com/go-acme/lego/v4/providers/dns/desec"
+	legoDigitalocean "github.com/go-acme/lego/v4/providers/dns/digitalocean"
+	legoDirectadmin "github.com/go-acme/lego/v4/providers/dns/directadmin"
+	legoDnsimple "github.com/go-acme/lego/v4/providers/dns/dnsimple"
+	legoDnsmadeeasy "github.com/go-acme/lego/v4/providers/dns/dnsmadeeasy"
+	legoDnspod "github.com/go-acme/lego/v4/providers/dns/dnspod"
+	legoDode "github.com/go-acme/lego/v4/providers/dns/dode"
+	legoDomeneshop "github.com/go-acme/lego/v4/providers/dns/domeneshop"
+	legoDreamhost "github.com/go-acme/lego/v4/providers/dns/dreamhost"
+	legoDuckdns "github.com/go-acme/lego/v4/providers/dns/duckdns"
+	legoDyn "github.com/go-acme/lego/v4/providers/dns/dyn"
+	legoDynu "github.com/go-acme/lego/v4/providers/dns/dynu"
+	legoEfficientip "github.com/go-acme/lego/v4/providers/dns/efficientip"
+	legoEpik "github.com/go-acme/lego/v4/providers/dns/epik"
+	legoFreemyip "github.com/go-acme/lego/v4/providers/dns/freemyip"
+	legoGandi "github.com/go-acme/lego/v4/providers/dns/gandi"
+	legoGandiv5 "github.com/go-acme/lego/v4/providers/dns/gandiv5"
+	legoGcore "github.com/go-acme/lego/v4/providers/dns/gcore"
+	legoGlesys "github.com/go-acme/lego/v4/providers/dns/glesys"
+	legoGodaddy "github.com/go-acme/lego/v4/providers/dns/godaddy"
+	legoGoogledomains "github.com/go-acme/lego/v4/providers/dns/googledomains"
+	legoHetzner "github.com/go-acme/lego/v4/providers/dns/hetzner"
+	legoHostingde "github.com/go-acme/lego/v4/providers/dns/hostingde"
+	legoHosttech "github.com/go-acme/lego/v4/providers/dns/hosttech"
+	legoHttpnet "github.com/go-acme/lego/v4/providers/dns/httpnet"
+	legoHyperone "github.com/go-acme/lego/v4/providers/dns/hyperone"
+	legoIbmcloud "github.com/go-acme/lego/v4/providers/dns/ibmcloud"
+	legoIij "github.com/go-acme/lego/v4/providers/dns/iij"
+	legoIijdpf "github.com/go-acme/lego/v4/providers/dns/iijdpf"
+	legoInfoblox "github.com/go-acme/lego/v4/providers/dns/infoblox"
+	legoInfomaniak "github.com/go-acme/lego/v4/providers/dns/infomaniak"
+	legoInternetbs "github.com/go-acme/lego/v4/providers/dns/internetbs"
+	legoInwx "github.com/go-acme/lego/v4/providers/dns/inwx"
+	legoIonos "github.com/go-acme/lego/v4/providers/dns/ionos"
+	legoIpv64 "github.com/go-acme/lego/v4/providers/dns/ipv64"
+	legoIwantmyname "github.com/go-acme/lego/v4/providers/dns/iwantmyname"
+	legoLiara "github.com/go-acme/lego/v4/providers/dns/liara"
+	legoLightsail "github.com/go-acme/lego/v4/providers/dns/lightsail"
+	legoLimacity "github.com/go-acme/lego/v4/providers/dns/limacity"
+	legoLinode "github.com/go-acme/lego/v4/providers/dns/linode"
+	legoLoopia "github.com/go-acme/lego/v4/providers/dns/loopia"
+	legoLuadns "github.com/go-acme/lego/v4/providers/dns/luadns"
+	legoMailinabox "github.com/go-acme/lego/v4/providers/dns/mailinabox"
+	legoMetaname "github.com/go-acme/lego/v4/providers/dns/metaname"
+	legoMijnhost "github.com/go-acme/lego/v4/providers/dns/mijnhost"
+	legoMittwald "github.com/go-acme/lego/v4/providers/dns/mittwald"
+	legoMydnsjp "github.com/go-acme/lego/v4/providers/dns/mydnsjp"
+	legoNamedotcom "github.com/go-acme/lego/v4/providers/dns/namedotcom"
+	legoNamesilo "github.com/go-acme/lego/v4/providers/dns/namesilo"
+	legoNearlyfreespeech "github.com/go-acme/lego/v4/providers/dns/nearlyfreespeech"
+	legoNetcup "github.com/go-acme/lego/v4/providers/dns/netcup"
+	legoNetlify "github.com/go-acme/lego/v4/providers/dns/netlify"
+	legoNifcloud "github.com/go-acme/lego/v4/providers/dns/nifcloud"
+	legoNjalla "github.com/go-acme/lego/v4/providers/dns/njalla"
+	legoNodion "github.com/go-acme/lego/v4/providers/dns/nodion"
+	legoNs1 "github.com/go-acme/lego/v4/providers/dns/ns1"
+	legoOtc "github.com/go-acme/lego/v4/providers/dns/otc"
+	legoPorkbun "github.com/go-acme/lego/v4/providers/dns/porkbun"
+	legoRackspace "github.com/go-acme/lego/v4/providers/dns/rackspace"
+	legoRcodezero "github.com/go-acme/lego/v4/providers/dns/rcodezero"
+	legoRegfish "github.com/go-acme/lego/v4/providers/dns/regfish"
+	legoRegru "github.com/go-acme/lego/v4/providers/dns/regru"
+	legoRfc2136 "github.com/go-acme/lego/v4/providers/dns/rfc2136"
+	legoRimuhosting "github.com/go-acme/lego/v4/providers/dns/rimuhosting"
+	legoSafedns "github.com/go-acme/lego/v4/providers/dns/safedns"
+	legoSakuracloud "github.com/go-acme/lego/v4/providers/dns/sakuracloud"
+	legoSelectel "github.com/go-acme/lego/v4/providers/dns/selectel"
+	legoSelectelv2 "github.com/go-acme/lego/v4/providers/dns/selectelv2"
+	legoServercow "github.com/go-acme/lego/v4/providers/dns/servercow"
+	legoShellrent "github.com/go-acme/lego/v4/providers/dns/shellrent"
+	legoSimply "github.com/go-acme/lego/v4/providers/dns/simply"
+	legoSonic "github.com/go-acme/lego/v4/providers/dns/sonic"
+	legoStackpath "github.com/go-acme/lego/v4/providers/dns/stackpath"
+	legoTechnitium "github.com/go-acme/lego/v4/providers/dns/technitium"
+	legoTencentcloud "github.com/go-acme/lego/v4/providers/dns/tencentcloud"
+	legoTimewebcloud "github.com/go-acme/lego/v4/providers/dns/timewebcloud"
+	legoUltradns "github.com/go-acme/lego/v4/providers/dns/ultradns"
+	legoVariomedia "github.com/go-acme/lego/v4/providers/dns/variomedia"
+	legoVegadns "github.com/go-acme/lego/v4/providers/dns/vegadns"
+	legoVercel "github.com/go-acme/lego/v4/providers/dns/vercel"
+	legoVinyldns "github.com/go-acme/lego/v4/providers/dns/vinyldns"
+	legoVkcloud "github.com/go-acme/lego/v4/providers/dns/vkcloud"
+	legoVolcengine "github.com/go-acme/lego/v4/providers/dns/volcengine"
+	legoVscale "github.com/go-acme/lego/v4/providers/dns/vscale"
+	legoVultr "github.com/go-acme/lego/v4/providers/dns/vultr"
+	legoWebnames "github.com/go-acme/lego/v4/providers/dns/webnames"
+	legoWebsupport "github.com/go-acme/lego/v4/providers/dns/websupport"
+	legoWedos "github.com/go-acme/lego/v4/providers/dns/wedos"
+	legoYandex "github.com/go-acme/lego/v4/providers/dns/yandex"
+	legoYandexcloud "github.com/go-acme/lego/v4/providers/dns/yandexcloud"
+	legoZonomi "github.com/go-acme/lego/v4/providers/dns/zonomi"
+)
+
+// lego 各 DNS 提供商文档中列出的选项（环境变量名），键为提供商名称（含别名）。
+var legoProviderOptions = map[string][]string{
+	"acme-dns":         {"ACME_DNS_API_BASE", "ACME_DNS_STORAGE_PATH"},
+	"acmedns":          {"ACME_DNS_API_BASE", "ACME_DNS_STORAGE_PATH"},
+	"alidns":           {"ALICLOUD_ACCESS_KEY", "ALICLOUD_HTTP_TIMEOUT", "ALICLOUD_POLLING_INTERVAL", "ALICLOUD_PROPAGATION_TIMEOUT", "ALICLOUD_RAM_ROLE", "ALICLOUD_REGION_ID", "ALICLOUD_SECRET_KEY", "ALICLOUD_SECURITY_TOKEN", "ALICLOUD_TTL"},
+	"allinkl":          {"ALL_INKL_HTTP_TIMEOUT", "ALL_INKL_LOGIN", "ALL_INKL_PASSWORD", "ALL_INKL_POLLING_INTERVAL", "ALL_INKL_PROPAGATION_TIMEOUT"},
+	"arvancloud":       {"ARVANCLOUD_API_KEY", "ARVANCLOUD_HTTP_TIMEOUT", "ARVANCLOUD_POLLING_INTERVAL", "ARVANCLOUD_PROPAGATION_TIMEOUT", "ARVANCLOUD_TTL"},
+	"auroradns":        {"AURORA_API_KEY", "AURORA_ENDPOINT", "AURORA_POLLING_INTERVAL", "AURORA_PROPAGATION_TIMEOUT", "AURORA_SECRET", "AURORA_TTL"},
+	"autodns":          {"AUTODNS_API_PASSWORD", "AUTODNS_API_USER", "AUTODNS_CONTEXT", "AUTODNS_ENDPOINT", "AUTODNS_HTTP_TIMEOUT", "AUTODNS_POLLING_INTERVAL", "AUTODNS_PROPAGATION_TIMEOUT", "AUTODNS_TTL"},
+	"azure":            {"AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_ENVIRONMENT", "AZURE_METADATA_ENDPOINT", "AZURE_POLLING_INTERVAL", "AZURE_PRIVATE_ZONE", "AZURE_PROPAGATION_TIMEOUT", "AZURE_RESOURCE_GROUP", "AZURE_SUBSCRIPTION_ID", "AZURE_TENANT_ID", "AZURE_TTL", "AZURE_ZONE_NAME"},
+	"azuredns":         {"AZURE_AUTH_METHOD", "AZURE_AUTH_MSI_TIMEOUT", "AZURE_CLIENT_CERTIFICATE_PATH", "AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_ENVIRONMENT", "AZURE_POLLING_INTERVAL", "AZURE_PRIVATE_ZONE", "AZURE_PROPAGATION_TIMEOUT", "AZURE_RESOURCE_GROUP", "AZURE_SERVICEDISCOVERY_FILTER", "AZURE_SUBSCRIPTION_ID", "AZURE_TENANT_ID", "AZURE_TTL", "AZURE_ZONE_NAME"},
+	"bindman":          {"BINDMAN_HTTP_TIMEOUT", "BINDMAN_MANAGER_ADDRESS", "BINDMAN_POLLING_INTERVAL", "BINDMAN_PROPAGATION_TIMEOUT"},
+	"bluecat":          {"BLUECAT_CONFIG_NAME", "BLUECAT_DEBUG", "BLUECAT_DNS_VIEW", "BLUECAT_HTTP_TIMEOUT", "BLUECAT_PASSWORD", "BLUECAT_POLLING_INTERVAL", "BLUECAT_PROPAGATION_TIMEOUT", "BLUECAT_SERVER_URL", "BLUECAT_SKIP_DEPLOY", "BLUECAT_TTL", "BLUECAT_USER_NAME"},
+	"brandit":          {"BRANDIT_API_KEY", "BRANDIT_API_USERNAME", "BRANDIT_HTTP_TIMEOUT", "BRANDIT_POLLING_INTERVAL", "BRANDIT_PROPAGATION_TIMEOUT", "BRANDIT_TTL"},
+	"bunny":            {"BUNNY_API_KEY", "BUNNY_POLLING_INTERVAL", "BUNNY_PROPAGATION_TIMEOUT", "BUNNY_TTL"},
+	"checkdomain":      {"CHECKDOMAIN_ENDPOINT", "CHECKDOMAIN_HTTP_TIMEOUT", "CHECKDOMAIN_POLLING_INTERVAL", "CHECKDOMAIN_PROPAGATION_TIMEOUT", "CHECKDOMAIN_TOKEN", "CHECKDOMAIN_TTL"},
+	"civo":             {"CIVO_POLLING_INTERVAL", "CIVO_PROPAGATION_TIMEOUT", "CIVO_TOKEN", "CIVO_TTL"},
+	"clouddns":         {"CLOUDDNS_CLIENT_ID", "CLOUDDNS_EMAIL", "CLOUDDNS_HTTP_TIMEOUT", "CLOUDDNS_PASSWORD", "CLOUDDNS_POLLING_INTERVAL", "CLOUDDNS_PROPAGATION_TIMEOUT", "CLOUDDNS_TTL"},
+	"cloudflare":       {"CF_API_EMAIL", "CF_API_KEY", "CF_DNS_API_TOKEN", "CF_ZONE_API_TOKEN", "CLOUDFLARE_API_KEY", "CLOUDFLARE_DNS_API_TOKEN", "CLOUDFLARE_EMAIL", "CLOUDFLARE_HTTP_TIMEOUT", "CLOUDFLARE_POLLING_INTERVAL", "CLOUDFLARE_PROPAGATION_TIMEOUT", "CLOUDFLARE_TTL", "CLOUDFLARE_ZONE_API_TOKEN"},
+	"cloudns":          {"CLOUDNS_AUTH_ID", "CLOUDNS_AUTH_PASSWORD", "CLOUDNS_HTTP_TIMEOUT", "CLOUDNS_POLLING_INTERVAL", "CLOUDNS_PROPAGATION_TIMEOUT", "CLOUDNS_SUB_AUTH_ID", "CLOUDNS_TTL"},
+	"cloudru":          {"CLOUDRU_HTTP_TIMEOUT", "CLOUDRU_KEY_ID", "CLOUDRU_POLLING_INTERVAL", "CLOUDRU_PROPAGATION_TIMEOUT", "CLOUDRU_SECRET", "CLOUDRU_SEQUENCE_INTERVAL", "CLOUDRU_SERVICE_INSTANCE_ID", "CLOUDRU_TTL"},
+	"cloudxns":         {"CLOUDXNS_API_KEY", "CLOUDXNS_HTTP_TIMEOUT", "CLOUDXNS_POLLING_INTERVAL", "CLOUDXNS_PROPAGATION_TIMEOUT", "CLOUDXNS_SECRET_KEY", "CLOUDXNS_TTL"},
+	"conoha":           {"CONOHA_API_PASSWORD", "CONOHA_API_USERNAME", "CONOHA_HTTP_TIMEOUT", "CONOHA_POLLING_INTERVAL", "CONOHA_PROPAGATION_TIMEOUT", "CONOHA_REGION", "CONOHA_TENANT_ID", "CONOHA_TTL"},
+	"constellix":       {"CONSTELLIX_API_KEY", "CONSTELLIX_HTTP_TIMEOUT", "CONSTELLIX_POLLING_INTERVAL", "CONSTELLIX_PROPAGATION_TIMEOUT", "CONSTELLIX_SECRET_KEY", "CONSTELLIX_TTL"},
+	"corenetworks":     {"CORENETWORKS_HTTP_TIMEOUT", "CORENETWORKS_LOGIN", "CORENETWORKS_PASSWORD", "CORENETWORKS_POLLING_INTERVAL", "CORENETWORKS_PROPAGATION_TIMEOUT", "CORENETWORKS_SEQUENCE_INTERVAL", "CORENETWORKS_TTL"},
+	"cpanel":           {"CPANEL_BASE_URL", "CPANEL_HTTP_TIMEOUT", "CPANEL_MODE", "CPANEL_POLLING_INTERVAL", "CPANEL_PROPAGATION_TIMEOUT", "CPANEL_REGION", "CPANEL_TOKEN", "CPANEL_TTL", "CPANEL_USERNAME"},
+	"derak":            {"DERAK_API_KEY", "DERAK_HTTP_TIMEOUT", "DERAK_POLLING_INTERVAL", "DERAK_PROPAGATION_TIMEOUT", "DERAK_TTL", "DERAK_WEBSITE_ID"},
+	"desec":            {"DESEC_HTTP_TIMEOUT", "DESEC_POLLING_INTERVAL", "DESEC_PROPAGATION_TIMEOUT", "DESEC_TOKEN", "DESEC_TTL"},
+	"designate":        {"DESIGNATE_POLLING_INTERVAL", "DESIGNATE_PROPAGATION_TIMEOUT", "DESIGNATE_TTL", "DESIGNATE_ZONE_NAME", "OS_APPLICATION_CREDENTIAL_ID", "OS_APPLICATION_CREDENTIAL_NAME", "OS_APPLICATION_CREDENTIAL_SECRET", "OS_AUTH_URL", "OS_PASSWORD", "OS_PROJECT_ID", "OS_PROJECT_NAME", "OS_REGION_NAME", "OS_TENANT_NAME", "OS_USERNAME", "OS_USER_ID"},
+	"digitalocean":     {"DO_API_URL", "DO_AUTH_TOKEN", "DO_HTTP_TIMEOUT", "DO_POLLING_INTERVAL", "DO_PROPAGATION_TIMEOUT", "DO_TTL"},
+	"directadmin":      {"DIRECTADMIN_API_URL", "DIRECTADMIN_HTTP_TIMEOUT", "DIRECTADMIN_PASSWORD", "DIRECTADMIN_POLLING_INTERVAL", "DIRECTADMIN_PROPAGATION_TIMEOUT", "DIRECTADMIN_TTL", "DIRECTADMIN_USERNAME", "DIRECTADMIN_ZONE_NAME"},
+	"dnshomede":        {"DNSHOMEDE_CREDENTIALS", "DNSHOMEDE_HTTP_TIMEOUT", "DNSHOMEDE_POLLING_INTERVAL", "DNSHOMEDE_PROPAGATION_TIMEOUT", "DNSHOMEDE_SEQUENCE_INTERVAL"},
+	"dnsimple":         {"DNSIMPLE_BASE_URL", "DNSIMPLE_DEBUG", "DNSIMPLE_OAUTH_TOKEN", "DNSIMPLE_POLLING_INTERVAL", "DNSIMPLE_PROPAGATION_TIMEOUT", "DNSIMPLE_TTL"},
+	"dnsmadeeasy":      {"DNSMADEEASY_API_KEY", "DNSMADEEASY_API_SECRET", "DNSMADEEASY_HTTP_TIMEOUT", "DNSMADEEASY_POLLING_INTERVAL", "DNSMADEEASY_PROPAGATION_TIMEOUT", "DNSMADEEASY_SANDBOX", "DNSMADEEASY_TTL"},
+	"dnspod":           {"DNSPOD_API_KEY", "DNSPOD_HTTP_TIMEOUT", "DNSPOD_POLLING_INTERVAL", "DNSPOD_PROPAGATION_TIMEOUT", "DNSPOD_TTL"},
+	"dode":             {"DODE_HTTP_TIMEOUT", "DODE_POLLING_INTERVAL", "DODE_PROPAGATION_TIMEOUT", "DODE_SEQUENCE_INTERVAL", "DODE_TOKEN", "DODE_TTL"},
+	"domainnameshop":   {"DOMENESHOP_API_SECRET", "DOMENESHOP_API_TOKEN", "DOMENESHOP_HTTP_TIMEOUT", "DOMENESHOP_POLLING_INTERVAL", "DOMENESHOP_PROPAGATION_TIMEOUT"},
+	"domeneshop":       {"DOMENESHOP_API_SECRET", "DOMENESHOP_API_TOKEN", "DOMENESHOP_HTTP_TIMEOUT", "DOMENESHOP_POLLING_INTERVAL", "DOMENESHOP_PROPAGATION_TIMEOUT"},
+	"dreamhost":        {"DREAMHOST_API_KEY", "DREAMHOST_HTTP_TIMEOUT", "DREAMHOST_POLLING_INTERVAL", "DREAMHOST_PROPAGATION_TIMEOUT", "DREAMHOST_TTL"},
+	"duckdns":          {"DUCKDNS_HTTP_TIMEOUT", "DUCKDNS_POLLING_INTERVAL", "DUCKDNS_PROPAGATION_TIMEOUT", "DUCKDNS_SEQUENCE_INTERVAL", "DUCKDNS_TOKEN", "DUCKDNS_TTL"},
+	"dyn":              {"DYN_CUSTOMER_NAME", "DYN_HTTP_TIMEOUT", "DYN_PASSWORD", "DYN_POLLING_INTERVAL", "DYN_PROPAGATION_TIMEOUT", "DYN_TTL", "DYN_USER_NAME"},
+	"dynu":             {"DYNU_API_KEY", "DYNU_HTTP_TIMEOUT", "DYNU_POLLING_INTERVAL", "DYNU_PROPAGATION_TIMEOUT", "DYNU_TTL"},
+	"easydns":          {"EASYDNS_ENDPOINT", "EASYDNS_HTTP_TIMEOUT", "EASYDNS_KEY", "EASYDNS_POLLING_INTERVAL", "EASYDNS_PROPAGATION_TIMEOUT", "EASYDNS_SEQUENCE_INTERVAL", "EASYDNS_TOKEN", "EASYDNS_TTL"},
+	"edgedns":          {"AKAMAI_ACCESS_TOKEN", "AKAMAI_CLIENT_SECRET", "AKAMAI_CLIENT_TOKEN", "AKAMAI_EDGERC", "AKAMAI_EDGERC_SECTION", "AKAMAI_HOST", "AKAMAI_POLLING_INTERVAL", "AKAMAI_PROPAGATION_TIMEOUT", "AKAMAI_TTL"},
+	"efficientip":      {"EFFICIENTIP_DNS_NAME", "EFFICIENTIP_HOSTNAME", "EFFICIENTIP_HTTP_TIMEOUT", "EFFICIENTIP_INSECURE_SKIP_VERIFY", "EFFICIENTIP_PASSWORD", "EFFICIENTIP_POLLING_INTERVAL", "EFFICIENTIP_PROPAGATION_TIMEOUT", "EFFICIENTIP_TTL", "EFFICIENTIP_USERNAME", "EFFICIENTIP_VIEW_NAME"},
+	"epik":             {"EPIK_HTTP_TIMEOUT", "EPIK_POLLING_INTERVAL", "EPIK_PROPAGATION_TIMEOUT", "EPIK_SIGNATURE", "EPIK_TTL"},
+	"exec":             {"EXEC_PATH", "EXEC_POLLING_INTERVAL", "EXEC_PROPAGATION_TIMEOUT", "EXEC_SEQUENCE_INTERVAL"},
+	"exoscale":         {"EXOSCALE_API_KEY", "EXOSCALE_API_SECRET", "EXOSCALE_ENDPOINT", "EXOSCALE_HTTP_TIMEOUT", "EXOSCALE_POLLING_INTERVAL", "EXOSCALE_PROPAGATION_TIMEOUT", "EXOSCALE_TTL"},
+	"fastdns":          {"AKAMAI_ACCESS_TOKEN", "AKAMAI_CLIENT_SECRET", "AKAMAI_CLIENT_TOKEN", "AKAMAI_EDGERC", "AKAMAI_EDGERC_SECTION", "AKAMAI_HOST", "AKAMAI_POLLING_INTERVAL", "AKAMAI_PROPAGATION_TIMEOUT", "AKAMAI_TTL"},
+	"freemyip":         {"FREEMYIP_HTTP_TIMEOUT", "FREEMYIP_POLLING_INTERVAL", "FREEMYIP_PROPAGATION_TIMEOUT", "FREEMYIP_SEQUENCE_INTERVAL", "FREEMYIP_TOKEN", "FREEMYIP_TTL"},
+	"gandi":            {"GANDI_API_KEY", "GANDI_HTTP_TIMEOUT", "GANDI_POLLING_INTERVAL", "GANDI_PROPAGATION_TIMEOUT", "GANDI_TTL"},
+	"gandiv5":          {"GANDIV5_API_KEY", "GANDIV5_HTTP_TIMEOUT", "GANDIV5_PERSONAL_ACCESS_TOKEN", "GANDIV5_POLLING_INTERVAL", "GANDIV5_PROPAGATION_TIMEOUT", "GANDIV5_TTL"},
+	"gcloud":           {"GCE_ALLOW_PRIVATE_ZONE", "GCE_DEBUG", "GCE_POLLING_INTERVAL", "GCE_PROJECT", "GCE_PROPAGATION_TIMEOUT", "GCE_SERVICE_ACCOUNT", "GCE_SERVICE_ACCOUNT_FILE", "GCE_TTL", "GCE_ZONE_ID"},
+	"gcore":            {"GCORE_HTTP_TIMEOUT", "GCORE_PERMANENT_API_TOKEN", "GCORE_POLLING_INTERVAL", "GCORE_PROPAGATION_TIMEOUT", "GCORE_TTL"},
+	"glesys":           {"GLESYS_API_KEY", "GLESYS_API_USER", "GLESYS_HTTP_TIMEOUT", "GLESYS_POLLING_INTERVAL", "GLESYS_PROPAGATION_TIMEOUT", "GLESYS_TTL"},
+	"godaddy":          {"GODADDY_API_KEY", "GODADDY_API_SECRET", "GODADDY_HTTP_TIMEOUT", "GODADDY_POLLING_INTERVAL", "GODADDY_PROPAGATION_TIMEOUT", "GODADDY_TTL"},
+	"googledomains":    {"GOOGLE_DOMAINS_ACCESS_TOKEN", "GOOGLE_DOMAINS_HTTP_TIMEOUT", "GOOGLE_DOMAINS_POLLING_INTERVAL", "GOOGLE_DOMAINS_PROPAGATION_TIMEOUT"},
+	"hetzner":          {"HETZNER_API_KEY", "HETZNER_HTTP_TIMEOUT", "HETZNER_POLLING_INTERVAL", "HETZNER_PROPAGATION_TIMEOUT", "HETZNER_TTL"},
+	"hostingde":        {"HOSTINGDE_API_KEY", "HOSTINGDE_HTTP_TIMEOUT", "HOSTINGDE_POLLING_INTERVAL", "HOSTINGDE_PROPAGATION_TIMEOUT", "HOSTINGDE_TTL", "HOSTINGDE_ZONE_NAME"},
+	"hosttech":         {"HOSTTECH_API_KEY", "HOSTTECH_HTTP_TIMEOUT", "HOSTTECH_PASSWORD", "HOSTTECH_POLLING_INTERVAL", "HOSTTECH_PROPAGATION_TIMEOUT", "HOSTTECH_TTL"},
+	"httpnet":          {"HTTPNET_API_KEY", "HTTPNET_HTTP_TIMEOUT", "HTTPNET_POLLING_INTERVAL", "HTTPNET_PROPAGATION_TIMEOUT", "HTTPNET_TTL", "HTTPNET_ZONE_NAME"},
+	"httpreq":          {"HTTPREQ_ENDPOINT", "HTTPREQ_HTTP_TIMEOUT", "HTTPREQ_MODE", "HTTPREQ_PASSWORD", "HTTPREQ_POLLING_INTERVAL", "HTTPREQ_PROPAGATION_TIMEOUT", "HTTPREQ_USERNAME"},
+	"huaweicloud":      {"HUAWEICLOUD_ACCESS_KEY_ID", "HUAWEICLOUD_HTTP_TIMEOUT", "HUAWEICLOUD_POLLING_INTERVAL", "HUAWEICLOUD_PROPAGATION_TIMEOUT", "HUAWEICLOUD_REGION", "HUAWEICLOUD_SECRET_ACCESS_KEY", "HUAWEICLOUD_TTL"},
+	"hurricane":        {"HURRICANE_HTTP_TIMEOUT", "HURRICANE_POLLING_INTERVAL", "HURRICANE_PROPAGATION_TIMEOUT", "HURRICANE_SEQUENCE_INTERVAL", "HURRICANE_TOKENS"},
+	"hyperone":         {"HYPERONE_API_URL", "HYPERONE_HTTP_TIMEOUT", "HYPERONE_LOCATION_ID", "HYPERONE_PASSPORT_LOCATION", "HYPERONE_POLLING_INTERVAL", "HYPERONE_PROPAGATION_TIMEOUT", "HYPERONE_TTL"},
+	"ibmcloud":         {"SOFTLAYER_API_KEY", "SOFTLAYER_DEBUG", "SOFTLAYER_POLLING_INTERVAL", "SOFTLAYER_PROPAGATION_TIMEOUT", "SOFTLAYER_TIMEOUT", "SOFTLAYER_TTL", "SOFTLAYER_USERNAME"},
+	"iij":              {"IIJ_API_ACCESS_KEY", "IIJ_API_SECRET_KEY", "IIJ_DO_SERVICE_CODE", "IIJ_POLLING_INTERVAL", "IIJ_PROPAGATION_TIMEOUT", "IIJ_TTL"},
+	"iijdpf":           {"IIJ_DPF_API_ENDPOINT", "IIJ_DPF_API_TOKEN", "IIJ_DPF_DPM_SERVICE_CODE", "IIJ_DPF_POLLING_INTERVAL", "IIJ_DPF_PROPAGATION_TIMEOUT", "IIJ_DPF_TTL"},
+	"infoblox":         {"INFOBLOX_DNS_VIEW", "INFOBLOX_HOST", "INFOBLOX_HTTP_TIMEOUT", "INFOBLOX_PASSWORD", "INFOBLOX_POLLING_INTERVAL", "INFOBLOX_PORT", "INFOBLOX_PROPAGATION_TIMEOUT", "INFOBLOX_SSL_VERIFY", "INFOBLOX_TTL", "INFOBLOX_USERNAME", "INFOBLOX_WAPI_VERSION"},
+	"infomaniak":       {"INFOMANIAK_ACCESS_TOKEN", "INFOMANIAK_ENDPOINT", "INFOMANIAK_HTTP_TIMEOUT", "INFOMANIAK_POLLING_INTERVAL", "INFOMANIAK_PROPAGATION_TIMEOUT", "INFOMANIAK_TTL"},
+	"internetbs":       {"INTERNET_BS_API_KEY", "INTERNET_BS_HTTP_TIMEOUT", "INTERNET_BS_PASSWORD", "INTERNET_BS_POLLING_INTERVAL", "INTERNET_BS_PROPAGATION_TIMEOUT", "INTERNET_BS_TTL"},
+	"inwx":             {"INWX_PASSWORD", "INWX_POLLING_INTERVAL", "INWX_PROPAGATION_TIMEOUT", "INWX_SANDBOX", "INWX_SHARED_SECRET", "INWX_TTL", "INWX_USERNAME"},
+	"ionos":            {"IONOS_API_KEY", "IONOS_HTTP_TIMEOUT", "IONOS_POLLING_INTERVAL", "IONOS_PROPAGATION_TIMEOUT", "IONOS_TTL"},
+	"ipv64":            {"IPV64_API_KEY", "IPV64_HTTP_TIMEOUT", "IPV64_POLLING_INTERVAL", "IPV64_PROPAGATION_TIMEOUT", "IPV64_TTL"},
+	"iwantmyname":      {"IWANTMYNAME_HTTP_TIMEOUT", "IWANTMYNAME_PASSWORD", "IWANTMYNAME_POLLING_INTERVAL", "IWANTMYNAME_PROPAGATION_TIMEOUT", "IWANTMYNAME_TTL", "IWANTMYNAME_USERNAME"},
+	"joker":            {"JOKER_API_KEY", "JOKER_API_MODE", "JOKER_DEBUG", "JOKER_HTTP_TIMEOUT", "JOKER_PASSWORD", "JOKER_POLLING_INTERVAL", "JOKER_PROPAGATION_TIMEOUT", "JOKER_SEQUENCE_INTERVAL", "JOKER_TTL", "JOKER_USERNAME"},
+	"liara":            {"LIARA_API_KEY", "LIARA_HTTP_TIMEOUT", "LIARA_POLLING_INTERVAL", "LIARA_PROPAGATION_TIMEOUT", "LIARA_TTL"},
+	"lightsail":        {"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SHARED_CREDENTIALS_FILE", "DNS_ZONE", "LIGHTSAIL_POLLING_INTERVAL", "LIGHTSAIL_PROPAGATION_TIMEOUT", "LIGHTSAIL_REGION"},
+	"limacity":         {"LIMACITY_API_KEY", "LIMACITY_HTTP_TIMEOUT", "LIMACITY_POLLING_INTERVAL", "LIMACITY_PROPAGATION_TIMEOUT", "LIMACITY_SEQUENCE_INTERVAL", "LIMACITY_TTL"},
+	"linode":           {"LINODE_HTTP_TIMEOUT", "LINODE_POLLING_INTERVAL", "LINODE_PROPAGATION_TIMEOUT", "LINODE_TOKEN", "LINODE_TTL"},
+	"linodev4":         {"LINODE_HTTP_TIMEOUT", "LINODE_POLLING_INTERVAL", "LINODE_PROPAGATION_TIMEOUT", "LINODE_TOKEN", "LINODE_TTL"},
+	"liquidweb":        {"LWAPI_HTTP_TIMEOUT", "LWAPI_PASSWORD", "LWAPI_POLLING_INTERVAL", "LWAPI_PROPAGATION_TIMEOUT", "LWAPI_TTL", "LWAPI_URL", "LWAPI_USERNAME", "LWAPI_ZONE"},
+	"loopia":           {"LOOPIA_API_PASSWORD", "LOOPIA_API_URL", "LOOPIA_API_USER", "LOOPIA_HTTP_TIMEOUT", "LOOPIA_POLLING_INTERVAL", "LOOPIA_PROPAGATION_TIMEOUT", "LOOPIA_TTL"},
+	"luadns":           {"LUADNS_API_TOKEN", "LUADNS_API_USERNAME", "LUADNS_HTTP_TIMEOUT", "LUADNS_POLLING_INTERVAL", "LUADNS_PROPAGATION_TIMEOUT", "LUADNS_TTL"},
+	"mailinabox":       {"MAILINABOX_BASE_URL", "MAILINABOX_EMAIL", "MAILINABOX_PASSWORD", "MAILINABOX_POLLING_INTERVAL", "MAILINABOX_PROPAGATION_TIMEOUT"},
+	"metaname":         {"METANAME_ACCOUNT_REFERENCE", "METANAME_API_KEY", "METANAME_POLLING_INTERVAL", "METANAME_PROPAGATION_TIMEOUT", "METANAME_TTL"},
+	"mijnhost":         {"MIJNHOST_API_KEY", "MIJNHOST_HTTP_TIMEOUT", "MIJNHOST_POLLING_INTERVAL", "MIJNHOST_PROPAGATION_TIMEOUT", "MIJNHOST_SEQUENCE_INTERVAL", "MIJNHOST_TTL"},
+	"mittwald":         {"MITTWALD_HTTP_TIMEOUT", "MITTWALD_POLLING_INTERVAL", "MITTWALD_PROPAGATION_TIMEOUT", "MITTWALD_SEQUENCE_INTERVAL", "MITTWALD_TOKEN", "MITTWALD_TTL"},
+	"mydnsjp":          {"MYDNSJP_HTTP_TIMEOUT", "MYDNSJP_MASTER_ID", "MYDNSJP_PASSWORD", "MYDNSJP_POLLING_INTERVAL", "MYDNSJP_PROPAGATION_TIMEOUT", "MYDNSJP_TTL"},
+	"mythicbeasts":     {"MYTHICBEASTS_API_ENDPOINT", "MYTHICBEASTS_AUTH_API_ENDPOINT", "MYTHICBEASTS_HTTP_TIMEOUT", "MYTHICBEASTS_PASSWORD", "MYTHICBEASTS_POLLING_INTERVAL", "MYTHICBEASTS_PROPAGATION_TIMEOUT", "MYTHICBEASTS_TTL", "MYTHICBEASTS_USERNAME"},
+	"namecheap":        {"NAMECHEAP_API_KEY", "NAMECHEAP_API_USER", "NAMECHEAP_HTTP_TIMEOUT", "NAMECHEAP_POLLING_INTERVAL", "NAMECHEAP_PROPAGATION_TIMEOUT", "NAMECHEAP_SANDBOX", "NAMECHEAP_TTL"},
+	"namedotcom":       {"NAMECOM_API_TOKEN", "NAMECOM_HTTP_TIMEOUT", "NAMECOM_POLLING_INTERVAL", "NAMECOM_PROPAGATION_TIMEOUT", "NAMECOM_SERVER", "NAMECOM_TTL", "NAMECOM_USERNAME"},
+	"namesilo":         {"NAMESILO_API_KEY", "NAMESILO_POLLING_INTERVAL", "NAMESILO_PROPAGATION_TIMEOUT", "NAMESILO_TTL"},
+	"nearlyfreespeech": {"NEARLYFREESPEECH_API_KEY", "NEARLYFREESPEECH_HTTP_TIMEOUT", "NEARLYFREESPEECH_LOGIN", "NEARLYFREESPEECH_POLLING_INTERVAL", "NEARLYFREESPEECH_PROPAGATION_TIMEOUT", "NEARLYFREESPEECH_SEQUENCE_INTERVAL", "NEARLYFREESPEECH_TTL"},
+	"netcup":           {"NETCUP_API_KEY", "NETCUP_API_PASSWORD", "NETCUP_CUSTOMER_NUMBER", "NETCUP_HTTP_TIMEOUT", "NETCUP_POLLING_INTERVAL", "NETCUP_PROPAGATION_TIMEOUT", "NETCUP_TTL"},
+	"netlify":          {"NETLIFY_HTTP_TIMEOUT", "NETLIFY_POLLING_INTERVAL", "NETLIFY_PROPAGATION_TIMEOUT", "NETLIFY_TOKEN", "NETLIFY_TTL"},
+	"nicmanager":       {"NICMANAGER_API_EMAIL", "NICMANAGER_API_LOGIN", "NICMANAGER_API_MODE", "NICMANAGER_API_OTP", "NICMANAGER_API_PASSWORD", "NICMANAGER_API_USERNAME", "NICMANAGER_HTTP_TIMEOUT", "NICMANAGER_MODE", "NICMANAGER_POLLING_INTERVAL", "NICMANAGER_PROPAGATION_TIMEOUT", "NICMANAGER_TTL"},
+	"nifcloud":         {"NIFCLOUD_ACCESS_KEY_ID", "NIFCLOUD_DNS_ENDPOINT", "NIFCLOUD_HTTP_TIMEOUT", "NIFCLOUD_POLLING_INTERVAL", "NIFCLOUD_PROPAGATION_TIMEOUT", "NIFCLOUD_SECRET_ACCESS_KEY", "NIFCLOUD_TTL"},
+	"njalla":           {"NJALLA_HTTP_TIMEOUT", "NJALLA_POLLING_INTERVAL", "NJALLA_PROPAGATION_TIMEOUT", "NJALLA_TOKEN", "NJALLA_TTL"},
+	"nodion":           {"NODION_API_TOKEN", "NODION_HTTP_TIMEOUT", "NODION_POLLING_INTERVAL", "NODION_PROPAGATION_TIMEOUT", "NODION_TTL"},
+	"ns1":              {"NS1_API_KEY", "NS1_HTTP_TIMEOUT", "NS1_POLLING_INTERVAL", "NS1_PROPAGATION_TIMEOUT", "NS1_TTL"},
+	"oraclecloud":      {"OCI_COMPARTMENT_OCID", "OCI_HTTP_TIMEOUT", "OCI_POLLING_INTERVAL", "OCI_PRIVKEY_FILE", "OCI_PRIVKEY_PASS", "OCI_PROPAGATION_TIMEOUT", "OCI_PUBKEY_FINGERPRINT", "OCI_REGION", "OCI_TENANCY_OCID", "OCI_TTL", "OCI_USER_OCID"},
+	"otc":              {"OTC_DOMAIN_NAME", "OTC_HTTP_TIMEOUT", "OTC_IDENTITY_ENDPOINT", "OTC_PASSWORD", "OTC_POLLING_INTERVAL", "OTC_PROJECT_NAME", "OTC_PROPAGATION_TIMEOUT", "OTC_SEQUENCE_INTERVAL", "OTC_TTL", "OTC_USER_NAME"},
+	"ovh":              {"OVH_ACCESS_TOKEN", "OVH_APPLICATION_KEY", "OVH_APPLICATION_SECRET", "OVH_CLIENT_ID", "OVH_CLIENT_SECRET", "OVH_CONSUMER_KEY", "OVH_ENDPOINT", "OVH_HTTP_TIMEOUT", "OVH_POLLING_INTERVAL", "OVH_PROPAGATION_TIMEOUT", "OVH_TTL"},
+	"pdns":             {"PDNS_API_KEY", "PDNS_API_URL", "PDNS_API_VERSION", "PDNS_HTTP_TIMEOUT", "PDNS_POLLING_INTERVAL", "PDNS_PROPAGATION_TIMEOUT", "PDNS_SERVER_NAME", "PDNS_TTL"},
+	"plesk":            {"PLESK_HTTP_TIMEOUT", "PLESK_PASSWORD", "PLESK_POLLING_INTERVAL", "PLESK_PROPAGATION_TIMEOUT", "PLESK_SERVER_BASE_URL", "PLESK_TTL", "PLESK_USERNAME"},
+	"porkbun":          {"PORKBUN_API_KEY", "PORKBUN_HTTP_TIMEOUT", "PORKBUN_POLLING_INTERVAL", "PORKBUN_PROPAGATION_TIMEOUT", "PORKBUN_SECRET_API_KEY", "PORKBUN_TTL"},
+	"rackspace":        {"RACKSPACE_API_KEY", "RACKSPACE_HTTP_TIMEOUT", "RACKSPACE_POLLING_INTERVAL", "RACKSPACE_PROPAGATION_TIMEOUT", "RACKSPACE_TTL", "RACKSPACE_USER"},
+	"rcodezero":        {"RCODEZERO_API_TOKEN", "RCODEZERO_HTTP_TIMEOUT", "RCODEZERO_POLLING_INTERVAL", "RCODEZERO_PROPAGATION_TIMEOUT", "RCODEZERO_TTL"},
+	"regfish":          {"REGFISH_API_KEY", "REGFISH_HTTP_TIMEOUT", "REGFISH_POLLING_INTERVAL", "REGFISH_PROPAGATION_TIMEOUT", "REGFISH_TTL"},
+	"regru":            {"REGRU_HTTP_TIMEOUT", "REGRU_PASSWORD", "REGRU_POLLING_INTERVAL", "REGRU_PROPAGATION_TIMEOUT", "REGRU_TLS_CERT", "REGRU_TLS_KEY", "REGRU_TTL", "REGRU_USERNAME"},
+	"rfc2136":          {"RFC2136_DNS_TIMEOUT", "RFC2136_NAMESERVER", "RFC2136_POLLING_INTERVAL", "RFC2136_PROPAGATION_TIMEOUT", "RFC2136_SEQUENCE_INTERVAL", "RFC2136_TSIG_ALGORITHM", "RFC2136_TSIG_FILE", "RFC2136_TSIG_KEY", "RFC2136_TSIG_SECRET", "RFC2136_TTL"},
+	"rimuhosting":      {"RIMUHOSTING_API_KEY", "RIMUHOSTING_HTTP_TIMEOUT", "RIMUHOSTING_POLLING_INTERVAL", "RIMUHOSTING_PROPAGATION_TIMEOUT", "RIMUHOSTING_TTL"},
+	"route53":          {"AWS_ACCESS_KEY_ID", "AWS_ASSUME_ROLE_ARN", "AWS_EXTERNAL_ID", "AWS_HOSTED_ZONE_ID", "AWS_MAX_RETRIES", "AWS_POLLING_INTERVAL", "AWS_PROFILE", "AWS_PROPAGATION_TIMEOUT", "AWS_REGION", "AWS_SDK_LOAD_CONFIG", "AWS_SECRET_ACCESS_KEY", "AWS_SHARED_CREDENTIALS_FILE", "AWS_TTL", "AWS_WAIT_FOR_RECORD_SETS_CHANGED"},
+	"safedns":          {"SAFEDNS_AUTH_TOKEN", "SAFEDNS_HTTP_TIMEOUT", "SAFEDNS_POLLING_INTERVAL", "SAFEDNS_PROPAGATION_TIMEOUT", "SAFEDNS_TTL"},
+	"sakuracloud":      {"SAKURACLOUD_ACCESS_TOKEN", "SAKURACLOUD_ACCESS_TOKEN_SECRET", "SAKURACLOUD_HTTP_TIMEOUT", "SAKURACLOUD_POLLING_INTERVAL", "SAKURACLOUD_PROPAGATION_TIMEOUT", "SAKURACLOUD_TTL"},
+	"scaleway":         {"SCW_ACCESS_KEY", "SCW_POLLING_INTERVAL", "SCW_PROJECT_ID", "SCW_PROPAGATION_TIMEOUT", "SCW_SECRET_KEY", "SCW_TTL"},
+	"selectel":         {"SELECTEL_API_TOKEN", "SELECTEL_BASE_URL", "SELECTEL_HTTP_TIMEOUT", "SELECTEL_POLLING_INTERVAL", "SELECTEL_PROPAGATION_TIMEOUT", "SELECTEL_TTL"},
+	"selectelv2":       {"SELECTELV2_ACCOUNT_ID", "SELECTELV2_BASE_URL", "SELECTELV2_HTTP_TIMEOUT", "SELECTELV2_PASSWORD", "SELECTELV2_POLLING_INTERVAL", "SELECTELV2_PROJECT_ID", "SELECTELV2_PROPAGATION_TIMEOUT", "SELECTELV2_TTL", "SELECTELV2_USERNAME"},
+	"selfhostde":       {"SELFHOSTDE_HTTP_TIMEOUT", "SELFHOSTDE_PASSWORD", "SELFHOSTDE_POLLING_INTERVAL", "SELFHOSTDE_PROPAGATION_TIMEOUT", "SELFHOSTDE_RECORDS_MAPPING", "SELFHOSTDE_TTL", "SELFHOSTDE_USERNAME"},
+	"servercow":        {"SERVERCOW_HTTP_TIMEOUT", "SERVERCOW_PASSWORD", "SERVERCOW_POLLING_INTERVAL", "SERVERCOW_PROPAGATION_TIMEOUT", "SERVERCOW_TTL", "SERVERCOW_USERNAME"},
+	"shellrent":        {"SHELLRENT_HTTP_TIMEOUT", "SHELLRENT_POLLING_INTERVAL", "SHELLRENT_PROPAGATION_TIMEOUT", "SHELLRENT_TOKEN", "SHELLRENT_TTL", "SHELLRENT_USERNAME"},
+	"simply":           {"SIMPLY_ACCOUNT_NAME", "SIMPLY_API_KEY", "SIMPLY_HTTP_TIMEOUT", "SIMPLY_POLLING_INTERVAL", "SIMPLY_PROPAGATION_TIMEOUT", "SIMPLY_TTL"},
+	"sonic":            {"SONIC_API_KEY", "SONIC_HTTP_TIMEOUT", "SONIC_POLLING_INTERVAL", "SONIC_PROPAGATION_TIMEOUT", "SONIC_SEQUENCE_INTERVAL", "SONIC_TTL", "SONIC_USER_ID"},
+	"stackpath":        {"STACKPATH_CLIENT_ID", "STACKPATH_CLIENT_SECRET", "STACKPATH_POLLING_INTERVAL", "STACKPATH_PROPAGATION_TIMEOUT", "STACKPATH_STACK_ID", "STACKPATH_TTL"},
+	"technitium":       {"TECHNITIUM_API_TOKEN", "TECHNITIUM_HTTP_TIMEOUT", "TECHNITIUM_POLLING_INTERVAL", "TECHNITIUM_PROPAGATION_TIMEOUT", "TECHNITIUM_SERVER_BASE_URL", "TECHNITIUM_TTL"},
+	"tencentcloud":     {"TENCENTCLOUD_HTTP_TIMEOUT", "TENCENTCLOUD_POLLING_INTERVAL", "TENCENTCLOUD_PROPAGATION_TIMEOUT", "TENCENTCLOUD_REGION", "TENCENTCLOUD_SECRET_ID", "TENCENTCLOUD_SECRET_KEY", "TENCENTCLOUD_SESSION_TOKEN", "TENCENTCLOUD_TTL"},
+	"timewebcloud":     {"TIMEWEBCLOUD_AUTH_TOKEN", "TIMEWEBCLOUD_HTTP_TIMEOUT", "TIMEWEBCLOUD_POLLING_INTERVAL", "TIMEWEBCLOUD_PROPAGATION_TIMEOUT"},
+	"transip":          {"TRANSIP_ACCOUNT_NAME", "TRANSIP_POLLING_INTERVAL", "TRANSIP_PRIVATE_KEY_PATH", "TRANSIP_PROPAGATION_TIMEOUT", "TRANSIP_TTL"},
+	"ultradns":         {"ULTRADNS_ENDPOINT", "ULTRADNS_PASSWORD", "ULTRADNS_POLLING_INTERVAL", "ULTRADNS_PROPAGATION_TIMEOUT", "ULTRADNS_TTL", "ULTRADNS_USERNAME"},
+	"variomedia":       {"VARIOMEDIA_API_TOKEN", "VARIOMEDIA_HTTP_TIMEOUT", "VARIOMEDIA_POLLING_INTERVAL", "VARIOMEDIA_PROPAGATION_TIMEOUT", "VARIOMEDIA_SEQUENCE_INTERVAL", "VARIOMEDIA_TTL"},
+	"vegadns":          {"SECRET_VEGADNS_KEY", "SECRET_VEGADNS_SECRET", "VEGADNS_POLLING_INTERVAL", "VEGADNS_PROPAGATION_TIMEOUT", "VEGADNS_TTL", "VEGADNS_URL"},
+	"vercel":           {"VERCEL_API_TOKEN", "VERCEL_HTTP_TIMEOUT", "VERCEL_POLLING_INTERVAL", "VERCEL_PROPAGATION_TIMEOUT", "VERCEL_TEAM_ID", "VERCEL_TTL"},
+	"versio":           {"VERSIO_ENDPOINT", "VERSIO_HTTP_TIMEOUT", "VERSIO_PASSWORD", "VERSIO_POLLING_INTERVAL", "VERSIO_PROPAGATION_TIMEOUT", "VERSIO_SEQUENCE_INTERVAL", "VERSIO_TTL", "VERSIO_USERNAME"},
+	"vinyldns":         {"VINYLDNS_ACCESS_KEY", "VINYLDNS_HOST", "VINYLDNS_POLLING_INTERVAL", "VINYLDNS_PROPAGATION_TIMEOUT", "VINYLDNS_SECRET_KEY", "VINYLDNS_TTL"},
+	"vkcloud":          {"VK_CLOUD_DNS_ENDPOINT", "VK_CLOUD_DOMAIN_NAME", "VK_CLOUD_IDENTITY_ENDPOINT", "VK_CLOUD_PASSWORD", "VK_CLOUD_POLLING_INTERVAL", "VK_CLOUD_PROJECT_ID", "VK_CLOUD_PROPAGATION_TIMEOUT", "VK_CLOUD_TTL", "VK_CLOUD_USERNAME"},
+	"volcengine":       {"VOLC_ACCESSKEY", "VOLC_HOST", "VOLC_HTTP_TIMEOUT", "VOLC_POLLING_INTERVAL", "VOLC_PROPAGATION_TIMEOUT", "VOLC_REGION", "VOLC_SCHEME", "VOLC_SECRETKEY", "VOLC_TTL"},
+	"vscale":           {"VSCALE_API_TOKEN", "VSCALE_BASE_URL", "VSCALE_HTTP_TIMEOUT", "VSCALE_POLLING_INTERVAL", "VSCALE_PROPAGATION_TIMEOUT", "VSCALE_TTL"},
+	"vultr":            {"VULTR_API_KEY", "VULTR_HTTP_TIMEOUT", "VULTR_POLLING_INTERVAL", "VULTR_PROPAGATION_TIMEOUT", "VULTR_TTL"},
+	"webnames":         {"WEBNAMES_API_KEY", "WEBNAMES_HTTP_TIMEOUT", "WEBNAMES_POLLING_INTERVAL", "WEBNAMES_PROPAGATION_TIMEOUT", "WEBNAMES_TTL"},
+	"websupport":       {"WEBSUPPORT_API_KEY", "WEBSUPPORT_HTTP_TIMEOUT", "WEBSUPPORT_POLLING_INTERVAL", "WEBSUPPORT_PROPAGATION_TIMEOUT", "WEBSUPPORT_SECRET", "WEBSUPPORT_SEQUENCE_INTERVAL", "WEBSUPPORT_TTL"},
+	"wedos":            {"WEDOS_HTTP_TIMEOUT", "WEDOS_POLLING_INTERVAL", "WEDOS_PROPAGATION_TIMEOUT", "WEDOS_TTL", "WEDOS_USERNAME", "WEDOS_WAPI_PASSWORD"},
+	"yandex":           {"YANDEX_HTTP_TIMEOUT", "YANDEX_PDD_TOKEN", "YANDEX_POLLING_INTERVAL", "YANDEX_PROPAGATION_TIMEOUT", "YANDEX_TTL"},
+	"yandex360":        {"YANDEX360_HTTP_TIMEOUT", "YANDEX360_OAUTH_TOKEN", "YANDEX360_ORG_ID", "YANDEX360_POLLING_INTERVAL", "YANDEX360_PROPAGATION_TIMEOUT", "YANDEX360_TTL"},
+	"yandexcloud":      {"YANDEX_CLOUD_FOLDER_ID", "YANDEX_CLOUD_IAM_TOKEN", "YANDEX_CLOUD_POLLING_INTERVAL", "YANDEX_CLOUD_PROPAGATION_TIMEOUT", "YANDEX_CLOUD_TTL"},
+	"zoneee":           {"ZONEEE_API_KEY", "ZONEEE_API_USER", "ZONEEE_ENDPOINT", "ZONEEE_HTTP_TIMEOUT", "ZONEEE_POLLING_INTERVAL", "ZONEEE_PROPAGATION_TIMEOUT", "ZONEEE_TTL"},
+	"zonomi":           {"ZONOMI_API_KEY", "ZONOMI_HTTP_TIMEOUT", "ZONOMI_POLLING_INTERVAL", "ZONOMI_PROPAGATION_TIMEOUT", "ZONOMI_TTL"},
+}
+
+// 可以直接通过配置创建的 lego DNS 提供商，键为提供商名称（含别名）。
+var legoProviderConfigs = map[string]*legoProviderConfig{
+	"allinkl": {
+		builder:  newLegoProviderBuilder(legoAllinkl.NewDefaultConfig, legoAllinkl.NewDNSProviderConfig),
+		required: []string{"ALL_INKL_LOGIN", "ALL_INKL_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"ALL_INKL_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"ALL_INKL_LOGIN":               {"Login", legoOptionString},
+			"ALL_INKL_PASSWORD":            {"Password", legoOptionString},
+			"ALL_INKL_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"ALL_INKL_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"arvancloud": {
+		builder:  newLegoProviderBuilder(legoArvancloud.NewDefaultConfig, legoArvancloud.NewDNSProviderConfig),
+		required: []string{"ARVANCLOUD_API_KEY"},
+		fields: map[string]legoConfigField{
+			"ARVANCLOUD_API_KEY":             {"APIKey", legoOptionString},
+			"ARVANCLOUD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"ARVANCLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"ARVANCLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"ARVANCLOUD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"auroradns": {
+		builder:  newLegoProviderBuilder(legoAuroradns.NewDefaultConfig, legoAuroradns.NewDNSProviderConfig),
+		required: []string{"AURORA_API_KEY", "AURORA_SECRET"},
+		fields: map[string]legoConfigField{
+			"AURORA_API_KEY":             {"APIKey", legoOptionString},
+			"AURORA_ENDPOINT":            {"BaseURL", legoOptionString},
+			"AURORA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"AURORA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"AURORA_SECRET":              {"Secret", legoOptionString},
+			"AURORA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"bindman": {
+		builder:  newLegoProviderBuilder(legoBindman.NewDefaultConfig, legoBindman.NewDNSProviderConfig),
+		required: []string{"BINDMAN_MANAGER_ADDRESS"},
+		fields: map[string]legoConfigField{
+			"BINDMAN_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"BINDMAN_MANAGER_ADDRESS":     {"BaseURL", legoOptionString},
+			"BINDMAN_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"BINDMAN_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"bluecat": {
+		builder:  newLegoProviderBuilder(legoBluecat.NewDefaultConfig, legoBluecat.NewDNSProviderConfig),
+		required: []string{"BLUECAT_SERVER_URL", "BLUECAT_USER_NAME", "BLUECAT_PASSWORD", "BLUECAT_CONFIG_NAME", "BLUECAT_DNS_VIEW"},
+		fields: map[string]legoConfigField{
+			"BLUECAT_CONFIG_NAME":         {"ConfigName", legoOptionString},
+			"BLUECAT_DEBUG":               {"Debug", legoOptionBool},
+			"BLUECAT_DNS_VIEW":            {"DNSView", legoOptionString},
+			"BLUECAT_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"BLUECAT_PASSWORD":            {"Password", legoOptionString},
+			"BLUECAT_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"BLUECAT_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"BLUECAT_SERVER_URL":          {"BaseURL", legoOptionString},
+			"BLUECAT_SKIP_DEPLOY":         {"SkipDeploy", legoOptionBool},
+			"BLUECAT_TTL":                 {"TTL", legoOptionInt},
+			"BLUECAT_USER_NAME":           {"UserName", legoOptionString},
+		},
+	},
+	"brandit": {
+		builder:  newLegoProviderBuilder(legoBrandit.NewDefaultConfig, legoBrandit.NewDNSProviderConfig),
+		required: []string{"BRANDIT_API_KEY", "BRANDIT_API_USERNAME"},
+		fields: map[string]legoConfigField{
+			"BRANDIT_API_KEY":             {"APIKey", legoOptionString},
+			"BRANDIT_API_USERNAME":        {"APIUsername", legoOptionString},
+			"BRANDIT_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"BRANDIT_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"BRANDIT_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"BRANDIT_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"bunny": {
+		builder:  newLegoProviderBuilder(legoBunny.NewDefaultConfig, legoBunny.NewDNSProviderConfig),
+		required: []string{"BUNNY_API_KEY"},
+		fields: map[string]legoConfigField{
+			"BUNNY_API_KEY":             {"APIKey", legoOptionString},
+			"BUNNY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"BUNNY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"BUNNY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"civo": {
+		builder:  newLegoProviderBuilder(legoCivo.NewDefaultConfig, legoCivo.NewDNSProviderConfig),
+		required: []string{"CIVO_TOKEN"},
+		fields: map[string]legoConfigField{
+			"CIVO_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CIVO_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CIVO_TOKEN":               {"Token", legoOptionString},
+			"CIVO_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"clouddns": {
+		builder:  newLegoProviderBuilder(legoClouddns.NewDefaultConfig, legoClouddns.NewDNSProviderConfig),
+		required: []string{"CLOUDDNS_CLIENT_ID", "CLOUDDNS_EMAIL", "CLOUDDNS_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"CLOUDDNS_CLIENT_ID":           {"ClientID", legoOptionString},
+			"CLOUDDNS_EMAIL":               {"Email", legoOptionString},
+			"CLOUDDNS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CLOUDDNS_PASSWORD":            {"Password", legoOptionString},
+			"CLOUDDNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CLOUDDNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CLOUDDNS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"cloudru": {
+		builder:  newLegoProviderBuilder(legoCloudru.NewDefaultConfig, legoCloudru.NewDNSProviderConfig),
+		required: []string{"CLOUDRU_SERVICE_INSTANCE_ID", "CLOUDRU_KEY_ID", "CLOUDRU_SECRET"},
+		fields: map[string]legoConfigField{
+			"CLOUDRU_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CLOUDRU_KEY_ID":              {"KeyID", legoOptionString},
+			"CLOUDRU_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CLOUDRU_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CLOUDRU_SECRET":              {"Secret", legoOptionString},
+			"CLOUDRU_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"CLOUDRU_SERVICE_INSTANCE_ID": {"ServiceInstanceID", legoOptionString},
+			"CLOUDRU_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"conoha": {
+		builder:  newLegoProviderBuilder(legoConoha.NewDefaultConfig, legoConoha.NewDNSProviderConfig),
+		required: []string{"CONOHA_TENANT_ID", "CONOHA_API_USERNAME", "CONOHA_API_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"CONOHA_API_PASSWORD":        {"Password", legoOptionString},
+			"CONOHA_API_USERNAME":        {"Username", legoOptionString},
+			"CONOHA_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CONOHA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CONOHA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CONOHA_REGION":              {"Region", legoOptionString},
+			"CONOHA_TENANT_ID":           {"TenantID", legoOptionString},
+			"CONOHA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"constellix": {
+		builder:  newLegoProviderBuilder(legoConstellix.NewDefaultConfig, legoConstellix.NewDNSProviderConfig),
+		required: []string{"CONSTELLIX_API_KEY", "CONSTELLIX_SECRET_KEY"},
+		fields: map[string]legoConfigField{
+			"CONSTELLIX_API_KEY":             {"APIKey", legoOptionString},
+			"CONSTELLIX_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CONSTELLIX_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CONSTELLIX_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CONSTELLIX_SECRET_KEY":          {"SecretKey", legoOptionString},
+			"CONSTELLIX_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"corenetworks": {
+		builder:  newLegoProviderBuilder(legoCorenetworks.NewDefaultConfig, legoCorenetworks.NewDNSProviderConfig),
+		required: []string{"CORENETWORKS_LOGIN", "CORENETWORKS_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"CORENETWORKS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CORENETWORKS_LOGIN":               {"Login", legoOptionString},
+			"CORENETWORKS_PASSWORD":            {"Password", legoOptionString},
+			"CORENETWORKS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CORENETWORKS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CORENETWORKS_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"CORENETWORKS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"cpanel": {
+		builder:  newLegoProviderBuilder(legoCpanel.NewDefaultConfig, legoCpanel.NewDNSProviderConfig),
+		required: []string{"CPANEL_USERNAME", "CPANEL_TOKEN", "CPANEL_BASE_URL"},
+		fields: map[string]legoConfigField{
+			"CPANEL_BASE_URL":            {"BaseURL", legoOptionString},
+			"CPANEL_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"CPANEL_MODE":                {"Mode", legoOptionString},
+			"CPANEL_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"CPANEL_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"CPANEL_TOKEN":               {"Token", legoOptionString},
+			"CPANEL_TTL":                 {"TTL", legoOptionInt},
+			"CPANEL_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"derak": {
+		builder:  newLegoProviderBuilder(legoDerak.NewDefaultConfig, legoDerak.NewDNSProviderConfig),
+		required: []string{"DERAK_API_KEY"},
+		fields: map[string]legoConfigField{
+			"DERAK_API_KEY":             {"APIKey", legoOptionString},
+			"DERAK_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DERAK_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DERAK_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DERAK_TTL":                 {"TTL", legoOptionInt},
+			"DERAK_WEBSITE_ID":          {"WebsiteID", legoOptionString},
+		},
+	},
+	"desec": {
+		builder:  newLegoProviderBuilder(legoDesec.NewDefaultConfig, legoDesec.NewDNSProviderConfig),
+		required: []string{"DESEC_TOKEN"},
+		fields: map[string]legoConfigField{
+			"DESEC_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DESEC_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DESEC_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DESEC_TOKEN":               {"Token", legoOptionString},
+			"DESEC_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"digitalocean": {
+		builder:  newLegoProviderBuilder(legoDigitalocean.NewDefaultConfig, legoDigitalocean.NewDNSProviderConfig),
+		required: []string{"DO_AUTH_TOKEN"},
+		fields: map[string]legoConfigField{
+			"DO_API_URL":             {"BaseURL", legoOptionString},
+			"DO_AUTH_TOKEN":          {"AuthToken", legoOptionString},
+			"DO_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DO_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DO_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DO_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"directadmin": {
+		builder:  newLegoProviderBuilder(legoDirectadmin.NewDefaultConfig, legoDirectadmin.NewDNSProviderConfig),
+		required: []string{"DIRECTADMIN_API_URL", "DIRECTADMIN_USERNAME", "DIRECTADMIN_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"DIRECTADMIN_API_URL":             {"BaseURL", legoOptionString},
+			"DIRECTADMIN_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DIRECTADMIN_PASSWORD":            {"Password", legoOptionString},
+			"DIRECTADMIN_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DIRECTADMIN_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DIRECTADMIN_TTL":                 {"TTL", legoOptionInt},
+			"DIRECTADMIN_USERNAME":            {"Username", legoOptionString},
+			"DIRECTADMIN_ZONE_NAME":           {"ZoneName", legoOptionString},
+		},
+	},
+	"dnsimple": {
+		builder: newLegoProviderBuilder(legoDnsimple.NewDefaultConfig, legoDnsimple.NewDNSProviderConfig),
+		fields: map[string]legoConfigField{
+			"DNSIMPLE_BASE_URL":            {"BaseURL", legoOptionString},
+			"DNSIMPLE_DEBUG":               {"Debug", legoOptionBool},
+			"DNSIMPLE_OAUTH_TOKEN":         {"AccessToken", legoOptionString},
+			"DNSIMPLE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DNSIMPLE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DNSIMPLE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"dnsmadeeasy": {
+		builder:  newLegoProviderBuilder(legoDnsmadeeasy.NewDefaultConfig, legoDnsmadeeasy.NewDNSProviderConfig),
+		required: []string{"DNSMADEEASY_API_KEY", "DNSMADEEASY_API_SECRET"},
+		fields: map[string]legoConfigField{
+			"DNSMADEEASY_API_KEY":             {"APIKey", legoOptionString},
+			"DNSMADEEASY_API_SECRET":          {"APISecret", legoOptionString},
+			"DNSMADEEASY_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DNSMADEEASY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DNSMADEEASY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DNSMADEEASY_SANDBOX":             {"Sandbox", legoOptionBool},
+			"DNSMADEEASY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"dnspod": {
+		builder:  newLegoProviderBuilder(legoDnspod.NewDefaultConfig, legoDnspod.NewDNSProviderConfig),
+		required: []string{"DNSPOD_API_KEY"},
+		fields: map[string]legoConfigField{
+			"DNSPOD_API_KEY":             {"LoginToken", legoOptionString},
+			"DNSPOD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DNSPOD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DNSPOD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DNSPOD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"dode": {
+		builder:  newLegoProviderBuilder(legoDode.NewDefaultConfig, legoDode.NewDNSProviderConfig),
+		required: []string{"DODE_TOKEN"},
+		fields: map[string]legoConfigField{
+			"DODE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DODE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DODE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DODE_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"DODE_TOKEN":               {"Token", legoOptionString},
+		},
+	},
+	"domainnameshop": {
+		builder:  newLegoProviderBuilder(legoDomeneshop.NewDefaultConfig, legoDomeneshop.NewDNSProviderConfig),
+		required: []string{"DOMENESHOP_API_TOKEN", "DOMENESHOP_API_SECRET"},
+		fields: map[string]legoConfigField{
+			"DOMENESHOP_API_SECRET":          {"APISecret", legoOptionString},
+			"DOMENESHOP_API_TOKEN":           {"APIToken", legoOptionString},
+			"DOMENESHOP_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DOMENESHOP_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DOMENESHOP_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"domeneshop": {
+		builder:  newLegoProviderBuilder(legoDomeneshop.NewDefaultConfig, legoDomeneshop.NewDNSProviderConfig),
+		required: []string{"DOMENESHOP_API_TOKEN", "DOMENESHOP_API_SECRET"},
+		fields: map[string]legoConfigField{
+			"DOMENESHOP_API_SECRET":          {"APISecret", legoOptionString},
+			"DOMENESHOP_API_TOKEN":           {"APIToken", legoOptionString},
+			"DOMENESHOP_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DOMENESHOP_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DOMENESHOP_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"dreamhost": {
+		builder:  newLegoProviderBuilder(legoDreamhost.NewDefaultConfig, legoDreamhost.NewDNSProviderConfig),
+		required: []string{"DREAMHOST_API_KEY"},
+		fields: map[string]legoConfigField{
+			"DREAMHOST_API_KEY":             {"APIKey", legoOptionString},
+			"DREAMHOST_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DREAMHOST_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DREAMHOST_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"duckdns": {
+		builder:  newLegoProviderBuilder(legoDuckdns.NewDefaultConfig, legoDuckdns.NewDNSProviderConfig),
+		required: []string{"DUCKDNS_TOKEN"},
+		fields: map[string]legoConfigField{
+			"DUCKDNS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DUCKDNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DUCKDNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DUCKDNS_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"DUCKDNS_TOKEN":               {"Token", legoOptionString},
+		},
+	},
+	"dyn": {
+		builder:  newLegoProviderBuilder(legoDyn.NewDefaultConfig, legoDyn.NewDNSProviderConfig),
+		required: []string{"DYN_CUSTOMER_NAME", "DYN_USER_NAME", "DYN_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"DYN_CUSTOMER_NAME":       {"CustomerName", legoOptionString},
+			"DYN_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DYN_PASSWORD":            {"Password", legoOptionString},
+			"DYN_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DYN_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DYN_TTL":                 {"TTL", legoOptionInt},
+			"DYN_USER_NAME":           {"UserName", legoOptionString},
+		},
+	},
+	"dynu": {
+		builder:  newLegoProviderBuilder(legoDynu.NewDefaultConfig, legoDynu.NewDNSProviderConfig),
+		required: []string{"DYNU_API_KEY"},
+		fields: map[string]legoConfigField{
+			"DYNU_API_KEY":             {"APIKey", legoOptionString},
+			"DYNU_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"DYNU_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"DYNU_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"DYNU_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"efficientip": {
+		builder:  newLegoProviderBuilder(legoEfficientip.NewDefaultConfig, legoEfficientip.NewDNSProviderConfig),
+		required: []string{"EFFICIENTIP_USERNAME", "EFFICIENTIP_PASSWORD", "EFFICIENTIP_HOSTNAME", "EFFICIENTIP_DNS_NAME"},
+		fields: map[string]legoConfigField{
+			"EFFICIENTIP_DNS_NAME":             {"DNSName", legoOptionString},
+			"EFFICIENTIP_HOSTNAME":             {"Hostname", legoOptionString},
+			"EFFICIENTIP_HTTP_TIMEOUT":         {"HTTPClient.Timeout", legoOptionSecond},
+			"EFFICIENTIP_INSECURE_SKIP_VERIFY": {"InsecureSkipVerify", legoOptionBool},
+			"EFFICIENTIP_PASSWORD":             {"Password", legoOptionString},
+			"EFFICIENTIP_POLLING_INTERVAL":     {"PollingInterval", legoOptionSecond},
+			"EFFICIENTIP_PROPAGATION_TIMEOUT":  {"PropagationTimeout", legoOptionSecond},
+			"EFFICIENTIP_USERNAME":             {"Username", legoOptionString},
+			"EFFICIENTIP_VIEW_NAME":            {"ViewName", legoOptionString},
+		},
+	},
+	"epik": {
+		builder:  newLegoProviderBuilder(legoEpik.NewDefaultConfig, legoEpik.NewDNSProviderConfig),
+		required: []string{"EPIK_SIGNATURE"},
+		fields: map[string]legoConfigField{
+			"EPIK_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"EPIK_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"EPIK_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"EPIK_SIGNATURE":           {"Signature", legoOptionString},
+			"EPIK_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"freemyip": {
+		builder:  newLegoProviderBuilder(legoFreemyip.NewDefaultConfig, legoFreemyip.NewDNSProviderConfig),
+		required: []string{"FREEMYIP_TOKEN"},
+		fields: map[string]legoConfigField{
+			"FREEMYIP_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"FREEMYIP_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"FREEMYIP_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"FREEMYIP_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"FREEMYIP_TOKEN":               {"Token", legoOptionString},
+			"FREEMYIP_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"gandi": {
+		builder:  newLegoProviderBuilder(legoGandi.NewDefaultConfig, legoGandi.NewDNSProviderConfig),
+		required: []string{"GANDI_API_KEY"},
+		fields: map[string]legoConfigField{
+			"GANDI_API_KEY":             {"APIKey", legoOptionString},
+			"GANDI_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"GANDI_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"GANDI_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"GANDI_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"gandiv5": {
+		builder: newLegoProviderBuilder(legoGandiv5.NewDefaultConfig, legoGandiv5.NewDNSProviderConfig),
+		fields: map[string]legoConfigField{
+			"GANDIV5_API_KEY":               {"APIKey", legoOptionString},
+			"GANDIV5_HTTP_TIMEOUT":          {"HTTPClient.Timeout", legoOptionSecond},
+			"GANDIV5_PERSONAL_ACCESS_TOKEN": {"PersonalAccessToken", legoOptionString},
+			"GANDIV5_POLLING_INTERVAL":      {"PollingInterval", legoOptionSecond},
+			"GANDIV5_PROPAGATION_TIMEOUT":   {"PropagationTimeout", legoOptionSecond},
+			"GANDIV5_TTL":                   {"TTL", legoOptionInt},
+		},
+	},
+	"gcore": {
+		builder:  newLegoProviderBuilder(legoGcore.NewDefaultConfig, legoGcore.NewDNSProviderConfig),
+		required: []string{"GCORE_PERMANENT_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"GCORE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"GCORE_PERMANENT_API_TOKEN": {"APIToken", legoOptionString},
+			"GCORE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"GCORE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"GCORE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"glesys": {
+		builder:  newLegoProviderBuilder(legoGlesys.NewDefaultConfig, legoGlesys.NewDNSProviderConfig),
+		required: []string{"GLESYS_API_USER", "GLESYS_API_KEY"},
+		fields: map[string]legoConfigField{
+			"GLESYS_API_KEY":             {"APIKey", legoOptionString},
+			"GLESYS_API_USER":            {"APIUser", legoOptionString},
+			"GLESYS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"GLESYS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"GLESYS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"GLESYS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"godaddy": {
+		builder:  newLegoProviderBuilder(legoGodaddy.NewDefaultConfig, legoGodaddy.NewDNSProviderConfig),
+		required: []string{"GODADDY_API_KEY", "GODADDY_API_SECRET"},
+		fields: map[string]legoConfigField{
+			"GODADDY_API_KEY":             {"APIKey", legoOptionString},
+			"GODADDY_API_SECRET":          {"APISecret", legoOptionString},
+			"GODADDY_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"GODADDY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"GODADDY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"GODADDY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"googledomains": {
+		builder:  newLegoProviderBuilder(legoGoogledomains.NewDefaultConfig, legoGoogledomains.NewDNSProviderConfig),
+		required: []string{"GOOGLE_DOMAINS_ACCESS_TOKEN"},
+		fields: map[string]legoConfigField{
+			"GOOGLE_DOMAINS_ACCESS_TOKEN":        {"AccessToken", legoOptionString},
+			"GOOGLE_DOMAINS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"GOOGLE_DOMAINS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"GOOGLE_DOMAINS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"hetzner": {
+		builder:  newLegoProviderBuilder(legoHetzner.NewDefaultConfig, legoHetzner.NewDNSProviderConfig),
+		required: []string{"HETZNER_API_KEY"},
+		fields: map[string]legoConfigField{
+			"HETZNER_API_KEY":             {"APIKey", legoOptionString},
+			"HETZNER_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"HETZNER_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"HETZNER_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"HETZNER_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"hostingde": {
+		builder:  newLegoProviderBuilder(legoHostingde.NewDefaultConfig, legoHostingde.NewDNSProviderConfig),
+		required: []string{"HOSTINGDE_API_KEY"},
+		fields: map[string]legoConfigField{
+			"HOSTINGDE_API_KEY":             {"APIKey", legoOptionString},
+			"HOSTINGDE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"HOSTINGDE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"HOSTINGDE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"HOSTINGDE_TTL":                 {"TTL", legoOptionInt},
+			"HOSTINGDE_ZONE_NAME":           {"ZoneName", legoOptionString},
+		},
+	},
+	"hosttech": {
+		builder:  newLegoProviderBuilder(legoHosttech.NewDefaultConfig, legoHosttech.NewDNSProviderConfig),
+		required: []string{"HOSTTECH_API_KEY"},
+		fields: map[string]legoConfigField{
+			"HOSTTECH_API_KEY":             {"APIKey", legoOptionString},
+			"HOSTTECH_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"HOSTTECH_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"HOSTTECH_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"HOSTTECH_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"httpnet": {
+		builder:  newLegoProviderBuilder(legoHttpnet.NewDefaultConfig, legoHttpnet.NewDNSProviderConfig),
+		required: []string{"HTTPNET_API_KEY"},
+		fields: map[string]legoConfigField{
+			"HTTPNET_API_KEY":             {"APIKey", legoOptionString},
+			"HTTPNET_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"HTTPNET_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"HTTPNET_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"HTTPNET_TTL":                 {"TTL", legoOptionInt},
+			"HTTPNET_ZONE_NAME":           {"ZoneName", legoOptionString},
+		},
+	},
+	"hyperone": {
+		builder: newLegoProviderBuilder(legoHyperone.NewDefaultConfig, legoHyperone.NewDNSProviderConfig),
+		fields: map[string]legoConfigField{
+			"HYPERONE_API_URL":             {"APIEndpoint", legoOptionString},
+			"HYPERONE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"HYPERONE_LOCATION_ID":         {"LocationID", legoOptionString},
+			"HYPERONE_PASSPORT_LOCATION":   {"PassportLocation", legoOptionString},
+			"HYPERONE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"HYPERONE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"HYPERONE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"ibmcloud": {
+		builder:  newLegoProviderBuilder(legoIbmcloud.NewDefaultConfig, legoIbmcloud.NewDNSProviderConfig),
+		required: []string{"SOFTLAYER_USERNAME", "SOFTLAYER_API_KEY"},
+		fields: map[string]legoConfigField{
+			"SOFTLAYER_API_KEY":             {"APIKey", legoOptionString},
+			"SOFTLAYER_DEBUG":               {"Debug", legoOptionBool},
+			"SOFTLAYER_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SOFTLAYER_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SOFTLAYER_TIMEOUT":             {"HTTPTimeout", legoOptionSecond},
+			"SOFTLAYER_TTL":                 {"TTL", legoOptionInt},
+			"SOFTLAYER_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"iij": {
+		builder:  newLegoProviderBuilder(legoIij.NewDefaultConfig, legoIij.NewDNSProviderConfig),
+		required: []string{"IIJ_API_ACCESS_KEY", "IIJ_API_SECRET_KEY", "IIJ_DO_SERVICE_CODE"},
+		fields: map[string]legoConfigField{
+			"IIJ_API_ACCESS_KEY":      {"AccessKey", legoOptionString},
+			"IIJ_API_SECRET_KEY":      {"SecretKey", legoOptionString},
+			"IIJ_DO_SERVICE_CODE":     {"DoServiceCode", legoOptionString},
+			"IIJ_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"IIJ_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"IIJ_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"iijdpf": {
+		builder:  newLegoProviderBuilder(legoIijdpf.NewDefaultConfig, legoIijdpf.NewDNSProviderConfig),
+		required: []string{"IIJ_DPF_API_TOKEN", "IIJ_DPF_DPM_SERVICE_CODE"},
+		fields: map[string]legoConfigField{
+			"IIJ_DPF_API_ENDPOINT":        {"Endpoint", legoOptionString},
+			"IIJ_DPF_API_TOKEN":           {"Token", legoOptionString},
+			"IIJ_DPF_DPM_SERVICE_CODE":    {"ServiceCode", legoOptionString},
+			"IIJ_DPF_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"IIJ_DPF_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"IIJ_DPF_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"infoblox": {
+		builder:  newLegoProviderBuilder(legoInfoblox.NewDefaultConfig, legoInfoblox.NewDNSProviderConfig),
+		required: []string{"INFOBLOX_HOST", "INFOBLOX_USERNAME", "INFOBLOX_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"INFOBLOX_DNS_VIEW":            {"DNSView", legoOptionString},
+			"INFOBLOX_HOST":                {"Host", legoOptionString},
+			"INFOBLOX_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionInt},
+			"INFOBLOX_PASSWORD":            {"Password", legoOptionString},
+			"INFOBLOX_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"INFOBLOX_PORT":                {"Port", legoOptionString},
+			"INFOBLOX_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"INFOBLOX_SSL_VERIFY":          {"SSLVerify", legoOptionBool},
+			"INFOBLOX_TTL":                 {"TTL", legoOptionInt},
+			"INFOBLOX_USERNAME":            {"Username", legoOptionString},
+			"INFOBLOX_WAPI_VERSION":        {"WapiVersion", legoOptionString},
+		},
+	},
+	"infomaniak": {
+		builder:  newLegoProviderBuilder(legoInfomaniak.NewDefaultConfig, legoInfomaniak.NewDNSProviderConfig),
+		required: []string{"INFOMANIAK_ACCESS_TOKEN"},
+		fields: map[string]legoConfigField{
+			"INFOMANIAK_ACCESS_TOKEN":        {"AccessToken", legoOptionString},
+			"INFOMANIAK_ENDPOINT":            {"APIEndpoint", legoOptionString},
+			"INFOMANIAK_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"INFOMANIAK_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"INFOMANIAK_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"INFOMANIAK_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"internetbs": {
+		builder:  newLegoProviderBuilder(legoInternetbs.NewDefaultConfig, legoInternetbs.NewDNSProviderConfig),
+		required: []string{"INTERNET_BS_API_KEY", "INTERNET_BS_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"INTERNET_BS_API_KEY":             {"APIKey", legoOptionString},
+			"INTERNET_BS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"INTERNET_BS_PASSWORD":            {"Password", legoOptionString},
+			"INTERNET_BS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"INTERNET_BS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"INTERNET_BS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"inwx": {
+		builder:  newLegoProviderBuilder(legoInwx.NewDefaultConfig, legoInwx.NewDNSProviderConfig),
+		required: []string{"INWX_USERNAME", "INWX_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"INWX_PASSWORD":            {"Password", legoOptionString},
+			"INWX_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"INWX_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"INWX_SANDBOX":             {"Sandbox", legoOptionBool},
+			"INWX_SHARED_SECRET":       {"SharedSecret", legoOptionString},
+			"INWX_TTL":                 {"TTL", legoOptionInt},
+			"INWX_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"ionos": {
+		builder:  newLegoProviderBuilder(legoIonos.NewDefaultConfig, legoIonos.NewDNSProviderConfig),
+		required: []string{"IONOS_API_KEY"},
+		fields: map[string]legoConfigField{
+			"IONOS_API_KEY":             {"APIKey", legoOptionString},
+			"IONOS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"IONOS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"IONOS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"IONOS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"ipv64": {
+		builder:  newLegoProviderBuilder(legoIpv64.NewDefaultConfig, legoIpv64.NewDNSProviderConfig),
+		required: []string{"IPV64_API_KEY"},
+		fields: map[string]legoConfigField{
+			"IPV64_API_KEY":             {"APIKey", legoOptionString},
+			"IPV64_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"IPV64_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"IPV64_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"iwantmyname": {
+		builder:  newLegoProviderBuilder(legoIwantmyname.NewDefaultConfig, legoIwantmyname.NewDNSProviderConfig),
+		required: []string{"IWANTMYNAME_USERNAME", "IWANTMYNAME_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"IWANTMYNAME_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"IWANTMYNAME_PASSWORD":            {"Password", legoOptionString},
+			"IWANTMYNAME_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"IWANTMYNAME_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"IWANTMYNAME_TTL":                 {"TTL", legoOptionInt},
+			"IWANTMYNAME_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"liara": {
+		builder:  newLegoProviderBuilder(legoLiara.NewDefaultConfig, legoLiara.NewDNSProviderConfig),
+		required: []string{"LIARA_API_KEY"},
+		fields: map[string]legoConfigField{
+			"LIARA_API_KEY":             {"APIKey", legoOptionString},
+			"LIARA_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"LIARA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LIARA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LIARA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"lightsail": {
+		builder: newLegoProviderBuilder(legoLightsail.NewDefaultConfig, legoLightsail.NewDNSProviderConfig),
+		fields: map[string]legoConfigField{
+			"DNS_ZONE":                      {"DNSZone", legoOptionString},
+			"LIGHTSAIL_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LIGHTSAIL_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LIGHTSAIL_REGION":              {"Region", legoOptionString},
+		},
+	},
+	"limacity": {
+		builder:  newLegoProviderBuilder(legoLimacity.NewDefaultConfig, legoLimacity.NewDNSProviderConfig),
+		required: []string{"LIMACITY_API_KEY"},
+		fields: map[string]legoConfigField{
+			"LIMACITY_API_KEY":             {"APIKey", legoOptionString},
+			"LIMACITY_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"LIMACITY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LIMACITY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LIMACITY_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"LIMACITY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"linode": {
+		builder:  newLegoProviderBuilder(legoLinode.NewDefaultConfig, legoLinode.NewDNSProviderConfig),
+		required: []string{"LINODE_TOKEN"},
+		fields: map[string]legoConfigField{
+			"LINODE_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionSecond},
+			"LINODE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LINODE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LINODE_TOKEN":               {"Token", legoOptionString},
+			"LINODE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"linodev4": {
+		builder:  newLegoProviderBuilder(legoLinode.NewDefaultConfig, legoLinode.NewDNSProviderConfig),
+		required: []string{"LINODE_TOKEN"},
+		fields: map[string]legoConfigField{
+			"LINODE_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionSecond},
+			"LINODE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LINODE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LINODE_TOKEN":               {"Token", legoOptionString},
+			"LINODE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"loopia": {
+		builder:  newLegoProviderBuilder(legoLoopia.NewDefaultConfig, legoLoopia.NewDNSProviderConfig),
+		required: []string{"LOOPIA_API_USER", "LOOPIA_API_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"LOOPIA_API_PASSWORD":        {"APIPassword", legoOptionString},
+			"LOOPIA_API_URL":             {"BaseURL", legoOptionString},
+			"LOOPIA_API_USER":            {"APIUser", legoOptionString},
+			"LOOPIA_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"LOOPIA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LOOPIA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LOOPIA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"luadns": {
+		builder:  newLegoProviderBuilder(legoLuadns.NewDefaultConfig, legoLuadns.NewDNSProviderConfig),
+		required: []string{"LUADNS_API_USERNAME", "LUADNS_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"LUADNS_API_TOKEN":           {"APIToken", legoOptionString},
+			"LUADNS_API_USERNAME":        {"APIUsername", legoOptionString},
+			"LUADNS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"LUADNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"LUADNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"LUADNS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"mailinabox": {
+		builder:  newLegoProviderBuilder(legoMailinabox.NewDefaultConfig, legoMailinabox.NewDNSProviderConfig),
+		required: []string{"MAILINABOX_BASE_URL", "MAILINABOX_EMAIL", "MAILINABOX_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"MAILINABOX_BASE_URL":            {"BaseURL", legoOptionString},
+			"MAILINABOX_EMAIL":               {"Email", legoOptionString},
+			"MAILINABOX_PASSWORD":            {"Password", legoOptionString},
+			"MAILINABOX_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"MAILINABOX_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"metaname": {
+		builder:  newLegoProviderBuilder(legoMetaname.NewDefaultConfig, legoMetaname.NewDNSProviderConfig),
+		required: []string{"METANAME_ACCOUNT_REFERENCE", "METANAME_API_KEY"},
+		fields: map[string]legoConfigField{
+			"METANAME_ACCOUNT_REFERENCE":   {"AccountReference", legoOptionString},
+			"METANAME_API_KEY":             {"APIKey", legoOptionString},
+			"METANAME_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"METANAME_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"METANAME_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"mijnhost": {
+		builder:  newLegoProviderBuilder(legoMijnhost.NewDefaultConfig, legoMijnhost.NewDNSProviderConfig),
+		required: []string{"MIJNHOST_API_KEY"},
+		fields: map[string]legoConfigField{
+			"MIJNHOST_API_KEY":             {"APIKey", legoOptionString},
+			"MIJNHOST_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"MIJNHOST_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"MIJNHOST_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"MIJNHOST_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"MIJNHOST_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"mittwald": {
+		builder:  newLegoProviderBuilder(legoMittwald.NewDefaultConfig, legoMittwald.NewDNSProviderConfig),
+		required: []string{"MITTWALD_TOKEN"},
+		fields: map[string]legoConfigField{
+			"MITTWALD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"MITTWALD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"MITTWALD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"MITTWALD_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"MITTWALD_TOKEN":               {"Token", legoOptionString},
+			"MITTWALD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"mydnsjp": {
+		builder:  newLegoProviderBuilder(legoMydnsjp.NewDefaultConfig, legoMydnsjp.NewDNSProviderConfig),
+		required: []string{"MYDNSJP_MASTER_ID", "MYDNSJP_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"MYDNSJP_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"MYDNSJP_MASTER_ID":           {"MasterID", legoOptionString},
+			"MYDNSJP_PASSWORD":            {"Password", legoOptionString},
+			"MYDNSJP_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"MYDNSJP_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"namedotcom": {
+		builder:  newLegoProviderBuilder(legoNamedotcom.NewDefaultConfig, legoNamedotcom.NewDNSProviderConfig),
+		required: []string{"NAMECOM_USERNAME", "NAMECOM_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"NAMECOM_API_TOKEN":           {"APIToken", legoOptionString},
+			"NAMECOM_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NAMECOM_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NAMECOM_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NAMECOM_SERVER":              {"Server", legoOptionString},
+			"NAMECOM_TTL":                 {"TTL", legoOptionInt},
+			"NAMECOM_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"namesilo": {
+		builder:  newLegoProviderBuilder(legoNamesilo.NewDefaultConfig, legoNamesilo.NewDNSProviderConfig),
+		required: []string{"NAMESILO_API_KEY"},
+		fields: map[string]legoConfigField{
+			"NAMESILO_API_KEY":             {"APIKey", legoOptionString},
+			"NAMESILO_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NAMESILO_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NAMESILO_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"nearlyfreespeech": {
+		builder:  newLegoProviderBuilder(legoNearlyfreespeech.NewDefaultConfig, legoNearlyfreespeech.NewDNSProviderConfig),
+		required: []string{"NEARLYFREESPEECH_API_KEY", "NEARLYFREESPEECH_LOGIN"},
+		fields: map[string]legoConfigField{
+			"NEARLYFREESPEECH_API_KEY":             {"APIKey", legoOptionString},
+			"NEARLYFREESPEECH_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NEARLYFREESPEECH_LOGIN":               {"Login", legoOptionString},
+			"NEARLYFREESPEECH_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NEARLYFREESPEECH_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NEARLYFREESPEECH_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"NEARLYFREESPEECH_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"netcup": {
+		builder:  newLegoProviderBuilder(legoNetcup.NewDefaultConfig, legoNetcup.NewDNSProviderConfig),
+		required: []string{"NETCUP_CUSTOMER_NUMBER", "NETCUP_API_KEY", "NETCUP_API_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"NETCUP_API_KEY":             {"Key", legoOptionString},
+			"NETCUP_API_PASSWORD":        {"Password", legoOptionString},
+			"NETCUP_CUSTOMER_NUMBER":     {"Customer", legoOptionString},
+			"NETCUP_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NETCUP_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NETCUP_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NETCUP_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"netlify": {
+		builder:  newLegoProviderBuilder(legoNetlify.NewDefaultConfig, legoNetlify.NewDNSProviderConfig),
+		required: []string{"NETLIFY_TOKEN"},
+		fields: map[string]legoConfigField{
+			"NETLIFY_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NETLIFY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NETLIFY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NETLIFY_TOKEN":               {"Token", legoOptionString},
+			"NETLIFY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"nifcloud": {
+		builder:  newLegoProviderBuilder(legoNifcloud.NewDefaultConfig, legoNifcloud.NewDNSProviderConfig),
+		required: []string{"NIFCLOUD_ACCESS_KEY_ID", "NIFCLOUD_SECRET_ACCESS_KEY"},
+		fields: map[string]legoConfigField{
+			"NIFCLOUD_ACCESS_KEY_ID":       {"AccessKey", legoOptionString},
+			"NIFCLOUD_DNS_ENDPOINT":        {"BaseURL", legoOptionString},
+			"NIFCLOUD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NIFCLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NIFCLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NIFCLOUD_SECRET_ACCESS_KEY":   {"SecretKey", legoOptionString},
+			"NIFCLOUD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"njalla": {
+		builder:  newLegoProviderBuilder(legoNjalla.NewDefaultConfig, legoNjalla.NewDNSProviderConfig),
+		required: []string{"NJALLA_TOKEN"},
+		fields: map[string]legoConfigField{
+			"NJALLA_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NJALLA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NJALLA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NJALLA_TOKEN":               {"Token", legoOptionString},
+			"NJALLA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"nodion": {
+		builder:  newLegoProviderBuilder(legoNodion.NewDefaultConfig, legoNodion.NewDNSProviderConfig),
+		required: []string{"NODION_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"NODION_API_TOKEN":           {"APIToken", legoOptionString},
+			"NODION_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NODION_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NODION_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NODION_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"ns1": {
+		builder:  newLegoProviderBuilder(legoNs1.NewDefaultConfig, legoNs1.NewDNSProviderConfig),
+		required: []string{"NS1_API_KEY"},
+		fields: map[string]legoConfigField{
+			"NS1_API_KEY":             {"APIKey", legoOptionString},
+			"NS1_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"NS1_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"NS1_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"NS1_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"otc": {
+		builder:  newLegoProviderBuilder(legoOtc.NewDefaultConfig, legoOtc.NewDNSProviderConfig),
+		required: []string{"OTC_DOMAIN_NAME", "OTC_USER_NAME", "OTC_PASSWORD", "OTC_PROJECT_NAME"},
+		fields: map[string]legoConfigField{
+			"OTC_DOMAIN_NAME":         {"DomainName", legoOptionString},
+			"OTC_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"OTC_IDENTITY_ENDPOINT":   {"IdentityEndpoint", legoOptionString},
+			"OTC_PASSWORD":            {"Password", legoOptionString},
+			"OTC_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"OTC_PROJECT_NAME":        {"ProjectName", legoOptionString},
+			"OTC_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"OTC_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"OTC_TTL":                 {"TTL", legoOptionInt},
+			"OTC_USER_NAME":           {"UserName", legoOptionString},
+		},
+	},
+	"porkbun": {
+		builder:  newLegoProviderBuilder(legoPorkbun.NewDefaultConfig, legoPorkbun.NewDNSProviderConfig),
+		required: []string{"PORKBUN_SECRET_API_KEY", "PORKBUN_API_KEY"},
+		fields: map[string]legoConfigField{
+			"PORKBUN_API_KEY":             {"APIKey", legoOptionString},
+			"PORKBUN_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"PORKBUN_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"PORKBUN_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"PORKBUN_SECRET_API_KEY":      {"SecretAPIKey", legoOptionString},
+			"PORKBUN_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"rackspace": {
+		builder:  newLegoProviderBuilder(legoRackspace.NewDefaultConfig, legoRackspace.NewDNSProviderConfig),
+		required: []string{"RACKSPACE_USER", "RACKSPACE_API_KEY"},
+		fields: map[string]legoConfigField{
+			"RACKSPACE_API_KEY":             {"APIKey", legoOptionString},
+			"RACKSPACE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"RACKSPACE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"RACKSPACE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"RACKSPACE_TTL":                 {"TTL", legoOptionInt},
+			"RACKSPACE_USER":                {"APIUser", legoOptionString},
+		},
+	},
+	"rcodezero": {
+		builder:  newLegoProviderBuilder(legoRcodezero.NewDefaultConfig, legoRcodezero.NewDNSProviderConfig),
+		required: []string{"RCODEZERO_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"RCODEZERO_API_TOKEN":           {"APIToken", legoOptionString},
+			"RCODEZERO_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"RCODEZERO_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"RCODEZERO_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"RCODEZERO_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"regfish": {
+		builder:  newLegoProviderBuilder(legoRegfish.NewDefaultConfig, legoRegfish.NewDNSProviderConfig),
+		required: []string{"REGFISH_API_KEY"},
+		fields: map[string]legoConfigField{
+			"REGFISH_API_KEY":             {"APIKey", legoOptionString},
+			"REGFISH_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"REGFISH_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"REGFISH_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"REGFISH_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"regru": {
+		builder:  newLegoProviderBuilder(legoRegru.NewDefaultConfig, legoRegru.NewDNSProviderConfig),
+		required: []string{"REGRU_USERNAME", "REGRU_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"REGRU_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"REGRU_PASSWORD":            {"Password", legoOptionString},
+			"REGRU_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"REGRU_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"REGRU_TLS_CERT":            {"TLSCert", legoOptionString},
+			"REGRU_TLS_KEY":             {"TLSKey", legoOptionString},
+			"REGRU_TTL":                 {"TTL", legoOptionInt},
+			"REGRU_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"rfc2136": {
+		builder:  newLegoProviderBuilder(legoRfc2136.NewDefaultConfig, legoRfc2136.NewDNSProviderConfig),
+		required: []string{"RFC2136_NAMESERVER"},
+		fields: map[string]legoConfigField{
+			"RFC2136_DNS_TIMEOUT":         {"DNSTimeout", legoOptionSecond},
+			"RFC2136_NAMESERVER":          {"Nameserver", legoOptionString},
+			"RFC2136_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"RFC2136_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"RFC2136_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"RFC2136_TSIG_ALGORITHM":      {"TSIGAlgorithm", legoOptionString},
+			"RFC2136_TSIG_FILE":           {"TSIGFile", legoOptionString},
+			"RFC2136_TSIG_KEY":            {"TSIGKey", legoOptionString},
+			"RFC2136_TSIG_SECRET":         {"TSIGSecret", legoOptionString},
+			"RFC2136_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"rimuhosting": {
+		builder:  newLegoProviderBuilder(legoRimuhosting.NewDefaultConfig, legoRimuhosting.NewDNSProviderConfig),
+		required: []string{"RIMUHOSTING_API_KEY"},
+		fields: map[string]legoConfigField{
+			"RIMUHOSTING_API_KEY":             {"APIKey", legoOptionString},
+			"RIMUHOSTING_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"RIMUHOSTING_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"RIMUHOSTING_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"RIMUHOSTING_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"safedns": {
+		builder:  newLegoProviderBuilder(legoSafedns.NewDefaultConfig, legoSafedns.NewDNSProviderConfig),
+		required: []string{"SAFEDNS_AUTH_TOKEN"},
+		fields: map[string]legoConfigField{
+			"SAFEDNS_AUTH_TOKEN":          {"AuthToken", legoOptionString},
+			"SAFEDNS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SAFEDNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SAFEDNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SAFEDNS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"sakuracloud": {
+		builder:  newLegoProviderBuilder(legoSakuracloud.NewDefaultConfig, legoSakuracloud.NewDNSProviderConfig),
+		required: []string{"SAKURACLOUD_ACCESS_TOKEN", "SAKURACLOUD_ACCESS_TOKEN_SECRET"},
+		fields: map[string]legoConfigField{
+			"SAKURACLOUD_ACCESS_TOKEN":        {"Token", legoOptionString},
+			"SAKURACLOUD_ACCESS_TOKEN_SECRET": {"Secret", legoOptionString},
+			"SAKURACLOUD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SAKURACLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SAKURACLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SAKURACLOUD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"selectel": {
+		builder:  newLegoProviderBuilder(legoSelectel.NewDefaultConfig, legoSelectel.NewDNSProviderConfig),
+		required: []string{"SELECTEL_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"SELECTEL_API_TOKEN":           {"Token", legoOptionString},
+			"SELECTEL_BASE_URL":            {"BaseURL", legoOptionString},
+			"SELECTEL_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SELECTEL_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SELECTEL_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SELECTEL_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"selectelv2": {
+		builder:  newLegoProviderBuilder(legoSelectelv2.NewDefaultConfig, legoSelectelv2.NewDNSProviderConfig),
+		required: []string{"SELECTELV2_USERNAME", "SELECTELV2_PASSWORD", "SELECTELV2_ACCOUNT_ID", "SELECTELV2_PROJECT_ID"},
+		fields: map[string]legoConfigField{
+			"SELECTELV2_ACCOUNT_ID":          {"Account", legoOptionString},
+			"SELECTELV2_BASE_URL":            {"BaseURL", legoOptionString},
+			"SELECTELV2_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SELECTELV2_PASSWORD":            {"Password", legoOptionString},
+			"SELECTELV2_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SELECTELV2_PROJECT_ID":          {"ProjectID", legoOptionString},
+			"SELECTELV2_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SELECTELV2_TTL":                 {"TTL", legoOptionInt},
+			"SELECTELV2_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"servercow": {
+		builder:  newLegoProviderBuilder(legoServercow.NewDefaultConfig, legoServercow.NewDNSProviderConfig),
+		required: []string{"SERVERCOW_USERNAME", "SERVERCOW_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"SERVERCOW_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SERVERCOW_PASSWORD":            {"Password", legoOptionString},
+			"SERVERCOW_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SERVERCOW_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SERVERCOW_TTL":                 {"TTL", legoOptionInt},
+			"SERVERCOW_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"shellrent": {
+		builder:  newLegoProviderBuilder(legoShellrent.NewDefaultConfig, legoShellrent.NewDNSProviderConfig),
+		required: []string{"SHELLRENT_USERNAME", "SHELLRENT_TOKEN"},
+		fields: map[string]legoConfigField{
+			"SHELLRENT_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SHELLRENT_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SHELLRENT_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SHELLRENT_TOKEN":               {"Token", legoOptionString},
+			"SHELLRENT_TTL":                 {"TTL", legoOptionInt},
+			"SHELLRENT_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"simply": {
+		builder:  newLegoProviderBuilder(legoSimply.NewDefaultConfig, legoSimply.NewDNSProviderConfig),
+		required: []string{"SIMPLY_ACCOUNT_NAME", "SIMPLY_API_KEY"},
+		fields: map[string]legoConfigField{
+			"SIMPLY_ACCOUNT_NAME":        {"AccountName", legoOptionString},
+			"SIMPLY_API_KEY":             {"APIKey", legoOptionString},
+			"SIMPLY_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SIMPLY_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SIMPLY_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SIMPLY_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"sonic": {
+		builder:  newLegoProviderBuilder(legoSonic.NewDefaultConfig, legoSonic.NewDNSProviderConfig),
+		required: []string{"SONIC_USER_ID", "SONIC_API_KEY"},
+		fields: map[string]legoConfigField{
+			"SONIC_API_KEY":             {"APIKey", legoOptionString},
+			"SONIC_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"SONIC_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"SONIC_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"SONIC_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"SONIC_TTL":                 {"TTL", legoOptionInt},
+			"SONIC_USER_ID":             {"UserID", legoOptionString},
+		},
+	},
+	"stackpath": {
+		builder:  newLegoProviderBuilder(legoStackpath.NewDefaultConfig, legoStackpath.NewDNSProviderConfig),
+		required: []string{"STACKPATH_CLIENT_ID", "STACKPATH_CLIENT_SECRET", "STACKPATH_STACK_ID"},
+		fields: map[string]legoConfigField{
+			"STACKPATH_CLIENT_ID":           {"ClientID", legoOptionString},
+			"STACKPATH_CLIENT_SECRET":       {"ClientSecret", legoOptionString},
+			"STACKPATH_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"STACKPATH_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"STACKPATH_STACK_ID":            {"StackID", legoOptionString},
+			"STACKPATH_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"technitium": {
+		builder:  newLegoProviderBuilder(legoTechnitium.NewDefaultConfig, legoTechnitium.NewDNSProviderConfig),
+		required: []string{"TECHNITIUM_SERVER_BASE_URL", "TECHNITIUM_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"TECHNITIUM_API_TOKEN":           {"APIToken", legoOptionString},
+			"TECHNITIUM_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"TECHNITIUM_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"TECHNITIUM_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"TECHNITIUM_SERVER_BASE_URL":     {"BaseURL", legoOptionString},
+			"TECHNITIUM_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"tencentcloud": {
+		builder:  newLegoProviderBuilder(legoTencentcloud.NewDefaultConfig, legoTencentcloud.NewDNSProviderConfig),
+		required: []string{"TENCENTCLOUD_SECRET_ID", "TENCENTCLOUD_SECRET_KEY"},
+		fields: map[string]legoConfigField{
+			"TENCENTCLOUD_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionSecond},
+			"TENCENTCLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"TENCENTCLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"TENCENTCLOUD_REGION":              {"Region", legoOptionString},
+			"TENCENTCLOUD_SECRET_ID":           {"SecretID", legoOptionString},
+			"TENCENTCLOUD_SECRET_KEY":          {"SecretKey", legoOptionString},
+			"TENCENTCLOUD_SESSION_TOKEN":       {"SessionToken", legoOptionString},
+			"TENCENTCLOUD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"timewebcloud": {
+		builder:  newLegoProviderBuilder(legoTimewebcloud.NewDefaultConfig, legoTimewebcloud.NewDNSProviderConfig),
+		required: []string{"TIMEWEBCLOUD_AUTH_TOKEN"},
+		fields: map[string]legoConfigField{
+			"TIMEWEBCLOUD_AUTH_TOKEN":          {"AuthToken", legoOptionString},
+			"TIMEWEBCLOUD_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"TIMEWEBCLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"TIMEWEBCLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"ultradns": {
+		builder:  newLegoProviderBuilder(legoUltradns.NewDefaultConfig, legoUltradns.NewDNSProviderConfig),
+		required: []string{"ULTRADNS_USERNAME", "ULTRADNS_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"ULTRADNS_ENDPOINT":            {"Endpoint", legoOptionString},
+			"ULTRADNS_PASSWORD":            {"Password", legoOptionString},
+			"ULTRADNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"ULTRADNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"ULTRADNS_TTL":                 {"TTL", legoOptionInt},
+			"ULTRADNS_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"variomedia": {
+		builder:  newLegoProviderBuilder(legoVariomedia.NewDefaultConfig, legoVariomedia.NewDNSProviderConfig),
+		required: []string{"VARIOMEDIA_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"VARIOMEDIA_API_TOKEN":           {"APIToken", legoOptionString},
+			"VARIOMEDIA_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"VARIOMEDIA_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VARIOMEDIA_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VARIOMEDIA_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"VARIOMEDIA_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"vegadns": {
+		builder:  newLegoProviderBuilder(legoVegadns.NewDefaultConfig, legoVegadns.NewDNSProviderConfig),
+		required: []string{"VEGADNS_URL"},
+		fields: map[string]legoConfigField{
+			"SECRET_VEGADNS_KEY":          {"APIKey", legoOptionString},
+			"SECRET_VEGADNS_SECRET":       {"APISecret", legoOptionString},
+			"VEGADNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VEGADNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VEGADNS_TTL":                 {"TTL", legoOptionInt},
+			"VEGADNS_URL":                 {"BaseURL", legoOptionString},
+		},
+	},
+	"vercel": {
+		builder:  newLegoProviderBuilder(legoVercel.NewDefaultConfig, legoVercel.NewDNSProviderConfig),
+		required: []string{"VERCEL_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"VERCEL_API_TOKEN":           {"AuthToken", legoOptionString},
+			"VERCEL_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"VERCEL_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VERCEL_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VERCEL_TEAM_ID":             {"TeamID", legoOptionString},
+			"VERCEL_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"vinyldns": {
+		builder:  newLegoProviderBuilder(legoVinyldns.NewDefaultConfig, legoVinyldns.NewDNSProviderConfig),
+		required: []string{"VINYLDNS_ACCESS_KEY", "VINYLDNS_SECRET_KEY", "VINYLDNS_HOST"},
+		fields: map[string]legoConfigField{
+			"VINYLDNS_ACCESS_KEY":          {"AccessKey", legoOptionString},
+			"VINYLDNS_HOST":                {"Host", legoOptionString},
+			"VINYLDNS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VINYLDNS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VINYLDNS_SECRET_KEY":          {"SecretKey", legoOptionString},
+			"VINYLDNS_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"vkcloud": {
+		builder:  newLegoProviderBuilder(legoVkcloud.NewDefaultConfig, legoVkcloud.NewDNSProviderConfig),
+		required: []string{"VK_CLOUD_PROJECT_ID", "VK_CLOUD_USERNAME", "VK_CLOUD_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"VK_CLOUD_DNS_ENDPOINT":        {"DNSEndpoint", legoOptionString},
+			"VK_CLOUD_DOMAIN_NAME":         {"DomainName", legoOptionString},
+			"VK_CLOUD_IDENTITY_ENDPOINT":   {"IdentityEndpoint", legoOptionString},
+			"VK_CLOUD_PASSWORD":            {"Password", legoOptionString},
+			"VK_CLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VK_CLOUD_PROJECT_ID":          {"ProjectID", legoOptionString},
+			"VK_CLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VK_CLOUD_TTL":                 {"TTL", legoOptionInt},
+			"VK_CLOUD_USERNAME":            {"Username", legoOptionString},
+		},
+	},
+	"volcengine": {
+		builder:  newLegoProviderBuilder(legoVolcengine.NewDefaultConfig, legoVolcengine.NewDNSProviderConfig),
+		required: []string{"VOLC_ACCESSKEY", "VOLC_SECRETKEY"},
+		fields: map[string]legoConfigField{
+			"VOLC_ACCESSKEY":           {"AccessKey", legoOptionString},
+			"VOLC_HOST":                {"Host", legoOptionString},
+			"VOLC_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionSecond},
+			"VOLC_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VOLC_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VOLC_REGION":              {"Region", legoOptionString},
+			"VOLC_SCHEME":              {"Scheme", legoOptionString},
+			"VOLC_SECRETKEY":           {"SecretKey", legoOptionString},
+			"VOLC_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"vscale": {
+		builder:  newLegoProviderBuilder(legoVscale.NewDefaultConfig, legoVscale.NewDNSProviderConfig),
+		required: []string{"VSCALE_API_TOKEN"},
+		fields: map[string]legoConfigField{
+			"VSCALE_API_TOKEN":           {"Token", legoOptionString},
+			"VSCALE_BASE_URL":            {"BaseURL", legoOptionString},
+			"VSCALE_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"VSCALE_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VSCALE_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VSCALE_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"vultr": {
+		builder:  newLegoProviderBuilder(legoVultr.NewDefaultConfig, legoVultr.NewDNSProviderConfig),
+		required: []string{"VULTR_API_KEY"},
+		fields: map[string]legoConfigField{
+			"VULTR_API_KEY":             {"APIKey", legoOptionString},
+			"VULTR_HTTP_TIMEOUT":        {"HTTPTimeout", legoOptionSecond},
+			"VULTR_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"VULTR_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"VULTR_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"webnames": {
+		builder:  newLegoProviderBuilder(legoWebnames.NewDefaultConfig, legoWebnames.NewDNSProviderConfig),
+		required: []string{"WEBNAMES_API_KEY"},
+		fields: map[string]legoConfigField{
+			"WEBNAMES_API_KEY":             {"APIKey", legoOptionString},
+			"WEBNAMES_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"WEBNAMES_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"WEBNAMES_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+		},
+	},
+	"websupport": {
+		builder:  newLegoProviderBuilder(legoWebsupport.NewDefaultConfig, legoWebsupport.NewDNSProviderConfig),
+		required: []string{"WEBSUPPORT_API_KEY", "WEBSUPPORT_SECRET"},
+		fields: map[string]legoConfigField{
+			"WEBSUPPORT_API_KEY":             {"APIKey", legoOptionString},
+			"WEBSUPPORT_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"WEBSUPPORT_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"WEBSUPPORT_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"WEBSUPPORT_SECRET":              {"Secret", legoOptionString},
+			"WEBSUPPORT_SEQUENCE_INTERVAL":   {"SequenceInterval", legoOptionSecond},
+			"WEBSUPPORT_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"wedos": {
+		builder:  newLegoProviderBuilder(legoWedos.NewDefaultConfig, legoWedos.NewDNSProviderConfig),
+		required: []string{"WEDOS_USERNAME", "WEDOS_WAPI_PASSWORD"},
+		fields: map[string]legoConfigField{
+			"WEDOS_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"WEDOS_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"WEDOS_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"WEDOS_TTL":                 {"TTL", legoOptionInt},
+			"WEDOS_USERNAME":            {"Username", legoOptionString},
+			"WEDOS_WAPI_PASSWORD":       {"Password", legoOptionString},
+		},
+	},
+	"yandex": {
+		builder:  newLegoProviderBuilder(legoYandex.NewDefaultConfig, legoYandex.NewDNSProviderConfig),
+		required: []string{"YANDEX_PDD_TOKEN"},
+		fields: map[string]legoConfigField{
+			"YANDEX_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"YANDEX_PDD_TOKEN":           {"PddToken", legoOptionString},
+			"YANDEX_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"YANDEX_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"YANDEX_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"yandexcloud": {
+		builder:  newLegoProviderBuilder(legoYandexcloud.NewDefaultConfig, legoYandexcloud.NewDNSProviderConfig),
+		required: []string{"YANDEX_CLOUD_IAM_TOKEN", "YANDEX_CLOUD_FOLDER_ID"},
+		fields: map[string]legoConfigField{
+			"YANDEX_CLOUD_FOLDER_ID":           {"FolderID", legoOptionString},
+			"YANDEX_CLOUD_IAM_TOKEN":           {"IamToken", legoOptionString},
+			"YANDEX_CLOUD_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"YANDEX_CLOUD_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"YANDEX_CLOUD_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+	"zonomi": {
+		builder:  newLegoProviderBuilder(legoZonomi.NewDefaultConfig, legoZonomi.NewDNSProviderConfig),
+		required: []string{"ZONOMI_API_KEY"},
+		fields: map[string]legoConfigField{
+			"ZONOMI_API_KEY":             {"APIKey", legoOptionString},
+			"ZONOMI_HTTP_TIMEOUT":        {"HTTPClient.Timeout", legoOptionSecond},
+			"ZONOMI_POLLING_INTERVAL":    {"PollingInterval", legoOptionSecond},
+			"ZONOMI_PROPAGATION_TIMEOUT": {"PropagationTimeout", legoOptionSecond},
+			"ZONOMI_TTL":                 {"TTL", legoOptionInt},
+		},
+	},
+}
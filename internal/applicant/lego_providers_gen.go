@@ -0,0 +1,656 @@
+//go:build ignore
+
+// 根据 lego 源码生成 lego_providers.go：
+//   - 各 DNS 提供商文档（providers/dns/*/*.toml）中列出的选项；
+//   - 凭据及配置只在 NewDefaultConfig 及 NewDNSProvider 中按固定模式读取的提供商，选项与配置字段的对应关系，
+//     这些提供商直接通过配置创建，不需要写入环境变量。
+//
+// 升级 lego 后在本目录执行 go generate 重新生成。
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const legoModule = "github.com/go-acme/lego/v4"
+
+type field struct {
+	path string
+	kind string
+}
+
+type provider struct {
+	dir      string
+	options  []string
+	direct   bool
+	required []string
+	fields   map[string]field
+}
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", legoModule).Output()
+	if err != nil {
+		fail(err)
+	}
+	legoDir := strings.TrimSpace(string(out))
+	providersDir := filepath.Join(legoDir, "providers", "dns")
+
+	names, err := parseProviderNames(filepath.Join(providersDir, "zz_gen_dns_providers.go"))
+	if err != nil {
+		fail(err)
+	}
+
+	providers := make(map[string]*provider)
+	for _, dir := range names {
+		if _, ok := providers[dir]; ok {
+			continue
+		}
+
+		p, err := parseProvider(filepath.Join(providersDir, dir), dir)
+		if err != nil {
+			fail(fmt.Errorf("%s: %w", dir, err))
+		}
+		providers[dir] = p
+	}
+
+	if err := os.WriteFile("lego_providers.go", render(names, providers), 0o644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// 解析 lego 按名称创建提供商的 switch 语句，得到名称（含别名）与提供商目录的对应关系。
+func parseProviderNames(file string) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string)
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		imports[path.Base(p)] = p
+	}
+
+	names := make(map[string]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		clause, ok := n.(*ast.CaseClause)
+		if !ok || len(clause.Body) == 0 {
+			return true
+		}
+
+		ret, ok := clause.Body[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return true
+		}
+		call, ok := ret.Results[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "NewDNSProvider" {
+			return true
+		}
+		pkg := sel.X.(*ast.Ident).Name
+		if !strings.HasPrefix(imports[pkg], legoModule+"/providers/dns/") {
+			return true
+		}
+
+		for _, expr := range clause.List {
+			name, _ := strconv.Unquote(expr.(*ast.BasicLit).Value)
+			names[name] = pkg
+		}
+		return true
+	})
+
+	return names, nil
+}
+
+func parseProvider(dir, name string) (*provider, error) {
+	p := &provider{dir: name, fields: make(map[string]field)}
+
+	options, err := parseDocumentedOptions(filepath.Join(dir, name+".toml"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+
+	consts := parseStringConsts(files)
+	a := &analyzer{consts: consts, provider: p, direct: true}
+
+	var newDefaultConfig, newDNSProvider *ast.FuncDecl
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil {
+				continue
+			}
+
+			switch fn.Name.Name {
+			case "NewDefaultConfig":
+				newDefaultConfig = fn
+			case "NewDNSProvider":
+				newDNSProvider = fn
+			default:
+				// 其他函数读取环境变量时，仅设置配置字段无法得到相同的结果
+				if usesEnv(fn) {
+					a.direct = false
+				}
+			}
+		}
+	}
+
+	if newDefaultConfig == nil || newDNSProvider == nil || !hasNewDNSProviderConfig(files) {
+		a.direct = false
+	} else {
+		a.parseNewDefaultConfig(newDefaultConfig)
+		a.parseNewDNSProvider(newDNSProvider)
+	}
+
+	set := make(map[string]bool)
+	for _, option := range options {
+		set[option] = true
+	}
+	for option := range p.fields {
+		set[option] = true
+	}
+	for option := range set {
+		p.options = append(p.options, option)
+	}
+	sort.Strings(p.options)
+
+	p.direct = a.direct
+	if !p.direct {
+		p.fields = nil
+		p.required = nil
+	}
+
+	return p, nil
+}
+
+var tomlOptionRegexp = regexp.MustCompile(`^\s*([A-Z][A-Z0-9_]*)\s*=`)
+
+// 读取文档中 [Configuration.Credentials] 及 [Configuration.Additional] 下列出的环境变量。
+func parseDocumentedOptions(file string) ([]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []string
+	inSection := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			inSection = line == "[Configuration.Credentials]" || line == "[Configuration.Additional]"
+			continue
+		}
+
+		if inSection {
+			if m := tomlOptionRegexp.FindStringSubmatch(line); m != nil {
+				options = append(options, m[1])
+			}
+		}
+	}
+
+	return options, scanner.Err()
+}
+
+func parseStringConsts(files []*ast.File) map[string]string {
+	exprs := make(map[string]ast.Expr)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i < len(vs.Values) {
+						exprs[name.Name] = vs.Values[i]
+					}
+				}
+			}
+		}
+	}
+
+	consts := make(map[string]string)
+	var eval func(expr ast.Expr) (string, bool)
+	eval = func(expr ast.Expr) (string, bool) {
+		switch e := expr.(type) {
+		case *ast.BasicLit:
+			if e.Kind != token.STRING {
+				return "", false
+			}
+			s, err := strconv.Unquote(e.Value)
+			return s, err == nil
+		case *ast.Ident:
+			if v, ok := exprs[e.Name]; ok {
+				return eval(v)
+			}
+		case *ast.BinaryExpr:
+			if e.Op == token.ADD {
+				l, ok1 := eval(e.X)
+				r, ok2 := eval(e.Y)
+				return l + r, ok1 && ok2
+			}
+		case *ast.ParenExpr:
+			return eval(e.X)
+		}
+		return "", false
+	}
+
+	for name, expr := range exprs {
+		if v, ok := eval(expr); ok {
+			consts[name] = v
+		}
+	}
+
+	return consts
+}
+
+func usesEnv(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && (x.Name == "env" || (x.Name == "os" && strings.HasPrefix(sel.Sel.Name, "Getenv")) || (x.Name == "os" && sel.Sel.Name == "LookupEnv")) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func hasNewDNSProviderConfig(files []*ast.File) bool {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Name.Name != "NewDNSProviderConfig" {
+				continue
+			}
+
+			params := fn.Type.Params.List
+			if len(params) != 1 || len(params[0].Names) != 1 {
+				return false
+			}
+			star, ok := params[0].Type.(*ast.StarExpr)
+			if !ok {
+				return false
+			}
+			ident, ok := star.X.(*ast.Ident)
+			return ok && ident.Name == "Config"
+		}
+	}
+	return false
+}
+
+type analyzer struct {
+	consts   map[string]string
+	provider *provider
+	direct   bool
+}
+
+var envKinds = map[string]string{
+	"GetOrDefaultString": "legoOptionString",
+	"GetOrDefaultInt":    "legoOptionInt",
+	"GetOrDefaultBool":   "legoOptionBool",
+	"GetOrDefaultSecond": "legoOptionSecond",
+	"GetOrFile":          "legoOptionString",
+}
+
+// 解析 env.GetOrDefaultXxx(EnvXxx, ...) 及 env.GetOrFile(EnvXxx) 调用，返回环境变量名及值的类型。
+func (a *analyzer) envCall(expr ast.Expr) (string, string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return "", "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "env" {
+		return "", "", false
+	}
+	kind, ok := envKinds[sel.Sel.Name]
+	if !ok {
+		return "", "", false
+	}
+	ident, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	name, ok := a.consts[ident.Name]
+	if !ok {
+		return "", "", false
+	}
+
+	return name, kind, true
+}
+
+func (a *analyzer) setField(option, path, kind string) {
+	for _, name := range strings.Split(path, ".") {
+		if !ast.IsExported(name) {
+			a.direct = false
+			return
+		}
+	}
+
+	if f, ok := a.provider.fields[option]; ok && (f.path != path || f.kind != kind) {
+		a.direct = false
+		return
+	}
+	a.provider.fields[option] = field{path: path, kind: kind}
+}
+
+func (a *analyzer) parseNewDefaultConfig(fn *ast.FuncDecl) {
+	if len(fn.Body.List) != 1 {
+		a.direct = false
+		return
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		a.direct = false
+		return
+	}
+	unary, ok := ret.Results[0].(*ast.UnaryExpr)
+	if !ok {
+		a.direct = false
+		return
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		a.direct = false
+		return
+	}
+
+	a.parseCompositeLit(lit, "")
+}
+
+func (a *analyzer) parseCompositeLit(lit *ast.CompositeLit, prefix string) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			if usesEnv(elt) {
+				a.direct = false
+			}
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			a.direct = false
+			continue
+		}
+		path := prefix + key.Name
+
+		if option, kind, ok := a.envCall(kv.Value); ok {
+			a.setField(option, path, kind)
+			continue
+		}
+
+		// 如 HTTPClient: &http.Client{Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, ...)}
+		if unary, ok := kv.Value.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			if nested, ok := unary.X.(*ast.CompositeLit); ok {
+				a.parseCompositeLit(nested, path+".")
+				continue
+			}
+		}
+
+		if usesEnv(kv.Value) {
+			a.direct = false
+		}
+	}
+}
+
+func (a *analyzer) parseNewDNSProvider(fn *ast.FuncDecl) {
+	valuesVar := ""
+
+	for _, stmt := range fn.Body.List {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if len(s.Lhs) == 2 && len(s.Rhs) == 1 {
+				// values, err := env.Get(EnvA, EnvB)
+				call, ok := s.Rhs[0].(*ast.CallExpr)
+				if !ok || !isSelector(call.Fun, "env", "Get") {
+					a.direct = false
+					return
+				}
+				for _, arg := range call.Args {
+					ident, ok := arg.(*ast.Ident)
+					if !ok {
+						a.direct = false
+						return
+					}
+					name, ok := a.consts[ident.Name]
+					if !ok {
+						a.direct = false
+						return
+					}
+					a.provider.required = append(a.provider.required, name)
+				}
+				valuesVar = s.Lhs[0].(*ast.Ident).Name
+				continue
+			}
+
+			if len(s.Lhs) != 1 || len(s.Rhs) != 1 {
+				a.direct = false
+				return
+			}
+
+			// config := NewDefaultConfig()
+			if ident, ok := s.Lhs[0].(*ast.Ident); ok && ident.Name == "config" {
+				call, ok := s.Rhs[0].(*ast.CallExpr)
+				if fun, isIdent := call.Fun.(*ast.Ident); !ok || !isIdent || fun.Name != "NewDefaultConfig" {
+					a.direct = false
+					return
+				}
+				continue
+			}
+
+			// config.Xxx = values[EnvXxx] / env.GetOrFile(EnvXxx) / env.GetOrDefaultXxx(EnvXxx, ...)
+			sel, ok := s.Lhs[0].(*ast.SelectorExpr)
+			if !ok {
+				a.direct = false
+				return
+			}
+			if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "config" {
+				a.direct = false
+				return
+			}
+
+			if index, ok := s.Rhs[0].(*ast.IndexExpr); ok {
+				x, ok1 := index.X.(*ast.Ident)
+				key, ok2 := index.Index.(*ast.Ident)
+				if !ok1 || !ok2 || x.Name != valuesVar {
+					a.direct = false
+					return
+				}
+				name, ok := a.consts[key.Name]
+				if !ok {
+					a.direct = false
+					return
+				}
+				a.setField(name, sel.Sel.Name, "legoOptionString")
+				continue
+			}
+
+			if option, kind, ok := a.envCall(s.Rhs[0]); ok {
+				a.setField(option, sel.Sel.Name, kind)
+				continue
+			}
+
+			a.direct = false
+			return
+
+		case *ast.IfStmt:
+			// if err != nil { return nil, fmt.Errorf(...) }
+			if s.Init != nil || s.Else != nil || usesEnv(s) {
+				a.direct = false
+				return
+			}
+			bin, ok := s.Cond.(*ast.BinaryExpr)
+			if !ok || bin.Op != token.NEQ {
+				a.direct = false
+				return
+			}
+			if x, ok := bin.X.(*ast.Ident); !ok || x.Name != "err" {
+				a.direct = false
+				return
+			}
+
+		case *ast.ReturnStmt:
+			// return NewDNSProviderConfig(config)
+			if len(s.Results) != 1 {
+				a.direct = false
+				return
+			}
+			call, ok := s.Results[0].(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				a.direct = false
+				return
+			}
+			fun, ok1 := call.Fun.(*ast.Ident)
+			arg, ok2 := call.Args[0].(*ast.Ident)
+			if !ok1 || !ok2 || fun.Name != "NewDNSProviderConfig" || arg.Name != "config" {
+				a.direct = false
+				return
+			}
+
+		default:
+			a.direct = false
+			return
+		}
+	}
+}
+
+func isSelector(expr ast.Expr, x, sel string) bool {
+	s, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := s.X.(*ast.Ident)
+	return ok && ident.Name == x && s.Sel.Name == sel
+}
+
+func importAlias(dir string) string {
+	return "lego" + strings.ToUpper(dir[:1]) + dir[1:]
+}
+
+func render(names map[string]string, providers map[string]*provider) []byte {
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	dirs := make([]string, 0, len(providers))
+	for dir, p := range providers {
+		if p.direct {
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by lego_providers_gen.go; DO NOT EDIT.\n\n")
+	b.WriteString("package applicant\n\n")
+	b.WriteString("import (\n")
+	for _, dir := range dirs {
+		fmt.Fprintf(&b, "\t%s %q\n", importAlias(dir), legoModule+"/providers/dns/"+dir)
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("// lego 各 DNS 提供商文档中列出的选项（环境变量名），键为提供商名称（含别名）。\n")
+	b.WriteString("var legoProviderOptions = map[string][]string{\n")
+	for _, name := range sortedNames {
+		p := providers[names[name]]
+		fmt.Fprintf(&b, "\t%q: {", name)
+		for i, option := range p.options {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%q", option)
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// 可以直接通过配置创建的 lego DNS 提供商，键为提供商名称（含别名）。\n")
+	b.WriteString("var legoProviderConfigs = map[string]*legoProviderConfig{\n")
+	for _, name := range sortedNames {
+		p := providers[names[name]]
+		if !p.direct {
+			continue
+		}
+
+		alias := importAlias(p.dir)
+		fmt.Fprintf(&b, "\t%q: {\n", name)
+		fmt.Fprintf(&b, "\t\tbuilder: newLegoProviderBuilder(%s.NewDefaultConfig, %s.NewDNSProviderConfig),\n", alias, alias)
+		if len(p.required) > 0 {
+			b.WriteString("\t\trequired: []string{")
+			for i, option := range p.required {
+				if i > 0 {
+					b.WriteString(", ")
+				}
+				fmt.Fprintf(&b, "%q", option)
+			}
+			b.WriteString("},\n")
+		}
+
+		options := make([]string, 0, len(p.fields))
+		for option := range p.fields {
+			options = append(options, option)
+		}
+		sort.Strings(options)
+
+		b.WriteString("\t\tfields: map[string]legoConfigField{\n")
+		for _, option := range options {
+			f := p.fields[option]
+			fmt.Fprintf(&b, "\t\t\t%q: {%q, %s},\n", option, f.path, f.kind)
+		}
+		b.WriteString("\t\t},\n")
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fail(err)
+	}
+	return src
+}
@@ -0,0 +1,140 @@
+package applicant
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+
+	"certimate/internal/domain"
+)
+
+func newTestLegoApplicant(provider string, options map[string]string) *legoApplicant {
+	access, _ := json.Marshal(&domain.LegoAccess{Provider: provider, Options: options})
+	return &legoApplicant{option: &ApplyOption{Access: string(access), Timeout: 90}}
+}
+
+func TestLegoDNSProviderOptionsAreScoped(t *testing.T) {
+	const n = 8
+
+	servers := make([]*httptest.Server, n)
+	for i := range servers {
+		username := fmt.Sprintf("user-%d", i)
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u, _, _ := r.BasicAuth(); u != username {
+				t.Errorf("server %s received credentials of %s", username, u)
+			}
+		}))
+		defer servers[i].Close()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			applicant := newTestLegoApplicant("httpreq", map[string]string{
+				"HTTPREQ_ENDPOINT": servers[i].URL,
+				"HTTPREQ_MODE":     "RAW",
+				"HTTPREQ_USERNAME": fmt.Sprintf("user-%d", i),
+				"HTTPREQ_PASSWORD": "secret",
+			})
+
+			provider, err := applicant.newDNSProvider()
+			if err != nil {
+				t.Errorf("failed to create provider: %v", err)
+				return
+			}
+
+			if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+				t.Errorf("failed to present: %v", err)
+			}
+
+			timeout, _ := provider.(challenge.ProviderTimeout).Timeout()
+			if timeout != 90*time.Second {
+				t.Errorf("unexpected propagation timeout: %v", timeout)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if _, ok := os.LookupEnv("HTTPREQ_ENDPOINT"); ok {
+		t.Error("lego dns provider options leaked into the environment")
+	}
+}
+
+func TestLegoDNSProviderInvalidOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		provider string
+		options  map[string]string
+	}{
+		{"EmptyProvider", "", nil},
+		{"Manual", "manual", nil},
+		{"Unknown", "not-a-provider", nil},
+		{"LowercaseKey", "httpreq", map[string]string{"httpreq_endpoint": "http://localhost"}},
+		{"LegoKey", "httpreq", map[string]string{"LEGO_DISABLE_CNAME_SUPPORT": "true"}},
+		{"UndocumentedKey", "httpreq", map[string]string{"PATH": "/tmp"}},
+		{"OtherProviderKey", "hetzner", map[string]string{"HTTPREQ_ENDPOINT": "http://localhost"}},
+		{"MissingCredentials", "porkbun", map[string]string{"PORKBUN_API_KEY": "key"}},
+		{"InvalidValue", "hetzner", map[string]string{"HETZNER_API_KEY": "key", "HETZNER_TTL": "abc"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := newTestLegoApplicant(c.provider, c.options).newDNSProvider(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestLegoDNSProviderFromConfig(t *testing.T) {
+	options := map[string]string{"HETZNER_API_KEY": "key", "HETZNER_TTL": "120"}
+
+	config, ok := legoProviderConfigs["hetzner"]
+	if !ok || !config.supports(options) {
+		t.Fatal("hetzner should be created from config")
+	}
+
+	provider, err := newTestLegoApplicant("hetzner", options).newDNSProvider()
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+	if provider == nil {
+		t.Fatal("provider is nil")
+	}
+
+	if _, ok := os.LookupEnv("HETZNER_API_KEY"); ok {
+		t.Error("lego dns provider options leaked into the environment")
+	}
+}
+
+func TestLegoProviderConfigFields(t *testing.T) {
+	samples := map[legoOptionKind]string{
+		legoOptionString: "value",
+		legoOptionInt:    "120",
+		legoOptionBool:   "true",
+		legoOptionSecond: "60",
+	}
+
+	for name, config := range legoProviderConfigs {
+		for key, field := range config.fields {
+			if !slices.Contains(legoProviderOptions[name], key) {
+				t.Errorf("%s: option %s is not documented", name, key)
+			}
+
+			if err := setLegoConfigField(config.builder.newConfig(), field, samples[field.kind]); err != nil {
+				t.Errorf("%s: failed to set option %s: %v", name, key, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package applicant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/miekg/dns"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+	"certimate/internal/repository"
+)
+
+// 默认等待管理员确认的时间，单位为分钟。
+const defaultManualDnsConfirmTimeout = 60
+
+const manualDnsPollingInterval = 10 * time.Second
+
+type ManualDnsChallengeRepository interface {
+	GetById(ctx context.Context, id string) (*domain.ManualDnsChallenge, error)
+	ListPendingByDomain(ctx context.Context, domainId string) ([]*domain.ManualDnsChallenge, error)
+	GetActiveByValue(ctx context.Context, domainId, fqdn, value string) (*domain.ManualDnsChallenge, error)
+	ListPendingDomainIds(ctx context.Context) ([]string, error)
+	Save(ctx context.Context, challenge *domain.ManualDnsChallenge) error
+	UpdateStatus(ctx context.Context, id string, status string) error
+	ExpirePendingByDomain(ctx context.Context, domainId string) error
+	ExpireOverdue(ctx context.Context) error
+}
+
+func getManualDnsChallengeRepository() ManualDnsChallengeRepository {
+	return repository.NewManualDnsChallengeRepository()
+}
+
+// 支持在申请过程中保存已有日志的记录器。
+// 手动 DNS 验证会等待较长时间，需要先保存日志，管理员才能在部署记录中看到要添加的 TXT 记录。
+type checkpointLogger interface {
+	Checkpoint()
+}
+
+type manualDnsApplicant struct {
+	option *ApplyOption
+}
+
+func NewManualDns(option *ApplyOption) (Applicant, error) {
+	// 等待确认的记录需要关联到域名，通过 CSR 签发等场景不支持
+	if option.DomainId == "" {
+		return nil, errors.New("manual dns challenge is only supported for domains")
+	}
+
+	return &manualDnsApplicant{
+		option: option,
+	}, nil
+}
+
+func (a *manualDnsApplicant) Apply() (*Certificate, error) {
+	repo := getManualDnsChallengeRepository()
+	provider, err := a.newDNSProvider(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一域名的申请依次进行，结束后剩余的等待记录不再有申请使用，如重启前 CA 已更换了质询
+	defer func() {
+		if err := repo.ExpirePendingByDomain(context.Background(), a.option.DomainId); err != nil {
+			a.option.Logger.Logf("清理手动 DNS 验证记录失败: %v", err)
+		}
+	}()
+
+	// 等待确认可能持续数小时，不占用 dns01Settings，避免阻塞其他申请；
+	// 因此 TXT 记录名称按本域名的 CNAME 跟随设置自行解析，不依赖其他申请设置的进程级开关，
+	// 记录是否生效也由提供商自行向申请配置中的 DNS 服务器查询
+	return applyWithChallenge(a.option, func(client *lego.Client) error {
+		return client.Challenge.SetDNS01Provider(provider, dns01.WrapPreCheck(provider.preCheck))
+	})
+}
+
+func (a *manualDnsApplicant) newDNSProvider(repo ManualDnsChallengeRepository) (*manualDnsProvider, error) {
+	access := &domain.ManualDnsAccess{}
+	if err := json.Unmarshal([]byte(a.option.Access), access); err != nil {
+		return nil, err
+	}
+
+	confirmTimeout := access.ConfirmTimeout
+	if confirmTimeout <= 0 {
+		confirmTimeout = defaultManualDnsConfirmTimeout
+	}
+
+	nameservers := parseNameservers(a.option.Nameservers)
+	if len(nameservers) == 0 {
+		nameservers = getDefaultNameservers()
+	} else {
+		nameservers = dns01.ParseNameservers(nameservers)
+	}
+
+	return &manualDnsProvider{
+		repo:               repo,
+		domainId:           a.option.DomainId,
+		timeout:            time.Duration(confirmTimeout) * time.Minute,
+		nameservers:        nameservers,
+		disableFollowCNAME: a.option.DisableFollowCNAME,
+		logger:             a.option.Logger,
+		challenges:         make(map[string]*domain.ManualDnsChallenge),
+	}, nil
+}
+
+// 将需要添加的 TXT 记录写入数据库及日志，等待管理员确认或在 DNS 服务器上查询到记录后再通知 CA 验证。
+// 服务重启后会重新申请仍在等待的域名，CA 复用未完成的质询时沿用已保存的记录，无需重新添加或确认。
+type manualDnsProvider struct {
+	repo               ManualDnsChallengeRepository
+	domainId           string
+	timeout            time.Duration
+	nameservers        []string
+	disableFollowCNAME bool
+	logger             deployer.Logger
+
+	mu         sync.Mutex
+	challenges map[string]*domain.ManualDnsChallenge
+	checkpoint sync.Once
+}
+
+func (p *manualDnsProvider) Present(identifier, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(identifier, keyAuth)
+	fqdn := getManualDnsChallengeFqdn(identifier, p.disableFollowCNAME, p.nameservers)
+
+	challenge, err := p.repo.GetActiveByValue(context.Background(), p.domainId, fqdn, info.Value)
+	if err != nil {
+		return fmt.Errorf("failed to get manual dns challenge: %w", err)
+	}
+
+	if challenge != nil {
+		p.logger.Logf("继续等待之前的 TXT 记录: %s TXT \"%s\"", fqdn, info.Value)
+	} else {
+		challenge = &domain.ManualDnsChallenge{
+			DomainId:   p.domainId,
+			Identifier: identifier,
+			Fqdn:       fqdn,
+			Value:      info.Value,
+			Status:     domain.ManualDnsChallengeStatusPending,
+			ExpiredAt:  time.Now().Add(p.timeout),
+		}
+		if err := p.repo.Save(context.Background(), challenge); err != nil {
+			return fmt.Errorf("failed to save manual dns challenge: %w", err)
+		}
+
+		p.logger.Logf("请添加 TXT 记录: %s TXT \"%s\"", fqdn, info.Value)
+	}
+
+	p.mu.Lock()
+	p.challenges[info.Value] = challenge
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *manualDnsProvider) CleanUp(identifier, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(identifier, keyAuth)
+
+	p.mu.Lock()
+	challenge, ok := p.challenges[info.Value]
+	delete(p.challenges, info.Value)
+	p.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	current, err := p.repo.GetById(context.Background(), challenge.Id)
+	if err == nil && current.Status == domain.ManualDnsChallengeStatusPending {
+		if err := p.repo.UpdateStatus(context.Background(), challenge.Id, domain.ManualDnsChallengeStatusExpired); err != nil {
+			return fmt.Errorf("failed to expire manual dns challenge: %w", err)
+		}
+	}
+
+	p.logger.Logf("验证结束，可以删除 TXT 记录: %s", challenge.Fqdn)
+	return nil
+}
+
+func (p *manualDnsProvider) Timeout() (time.Duration, time.Duration) {
+	return p.timeout, manualDnsPollingInterval
+}
+
+// 替代 lego 的传播检查，管理员确认或在所有 DNS 服务器上查询到记录时通过。
+// lego 传入的名称受进程级 CNAME 跟随开关影响，查询时使用保存的记录名称。
+func (p *manualDnsProvider) preCheck(identifier, _, value string, _ dns01.PreCheckFunc) (bool, error) {
+	p.checkpoint.Do(func() {
+		p.logger.Logf("等待添加 TXT 记录，可在添加后手动确认或等待自动检测，最长等待 %s", p.timeout)
+		if logger, ok := p.logger.(checkpointLogger); ok {
+			logger.Checkpoint()
+		}
+	})
+
+	p.mu.Lock()
+	challenge, ok := p.challenges[value]
+	p.mu.Unlock()
+	if !ok {
+		return false, fmt.Errorf("manual dns challenge for %s not found", identifier)
+	}
+
+	current, err := p.repo.GetById(context.Background(), challenge.Id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get manual dns challenge: %w", err)
+	}
+
+	switch current.Status {
+	case domain.ManualDnsChallengeStatusConfirmed:
+		p.logger.Logf("TXT 记录已确认: %s", challenge.Fqdn)
+		return true, nil
+	case domain.ManualDnsChallengeStatusExpired:
+		return false, fmt.Errorf("manual dns challenge for %s has expired", identifier)
+	}
+	if time.Now().After(current.ExpiredAt) {
+		return false, fmt.Errorf("manual dns challenge for %s has expired", identifier)
+	}
+
+	if lookupManualDnsTXT(p.nameservers, challenge.Fqdn, value) {
+		if err := p.repo.UpdateStatus(context.Background(), challenge.Id, domain.ManualDnsChallengeStatusConfirmed); err != nil {
+			return false, fmt.Errorf("failed to confirm manual dns challenge: %w", err)
+		}
+
+		p.logger.Logf("已在 DNS 服务器上查询到 TXT 记录: %s", challenge.Fqdn)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// 按域名自身的设置解析需要添加 TXT 记录的名称，与 lego 跟随 CNAME 的方式一致。
+//
+// 入参：
+//   - identifier: 申请的域名。
+//   - disableFollowCNAME: 是否禁止跟随 CNAME。
+//   - nameservers: 递归 DNS 服务器。
+//
+// 出参：
+//   - 以 . 结尾的完整记录名称。
+func getManualDnsChallengeFqdn(identifier string, disableFollowCNAME bool, nameservers []string) string {
+	fqdn := dns.Fqdn("_acme-challenge." + dns01.UnFqdn(identifier))
+	if disableFollowCNAME || len(nameservers) == 0 {
+		return fqdn
+	}
+
+	client := &dns.Client{Timeout: 10 * time.Second}
+	for range 50 {
+		msg := new(dns.Msg)
+		msg.SetQuestion(fqdn, dns.TypeCNAME)
+		msg.RecursionDesired = true
+
+		var cname string
+		for _, ns := range nameservers {
+			in, _, err := client.Exchange(msg, ns)
+			if err != nil || in.Rcode != dns.RcodeSuccess {
+				continue
+			}
+
+			for _, rr := range in.Answer {
+				if cn, ok := rr.(*dns.CNAME); ok && strings.EqualFold(cn.Hdr.Name, fqdn) {
+					cname = cn.Target
+					break
+				}
+			}
+			break
+		}
+		if cname == "" || cname == fqdn {
+			break
+		}
+
+		fqdn = cname
+	}
+
+	return fqdn
+}
+
+// 在所有 DNS 服务器上都能查询到指定的 TXT 记录时返回 true。
+func lookupManualDnsTXT(nameservers []string, fqdn, value string) bool {
+	if len(nameservers) == 0 {
+		return false
+	}
+
+	client := &dns.Client{Timeout: 10 * time.Second}
+	for _, ns := range nameservers {
+		msg := new(dns.Msg)
+		msg.SetQuestion(dns.Fqdn(fqdn), dns.TypeTXT)
+
+		in, _, err := client.Exchange(msg, ns)
+		if err != nil || in.Rcode != dns.RcodeSuccess {
+			return false
+		}
+
+		found := false
+		for _, rr := range in.Answer {
+			if txt, ok := rr.(*dns.TXT); ok && strings.Join(txt.Txt, "") == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+type ManualDnsService struct {
+	repo ManualDnsChallengeRepository
+}
+
+func NewManualDnsService() *ManualDnsService {
+	return &ManualDnsService{
+		repo: getManualDnsChallengeRepository(),
+	}
+}
+
+// 查询域名下等待添加的 TXT 记录。
+func (s *ManualDnsService) List(ctx context.Context, req *domain.ManualDnsChallengeListReq) ([]*domain.ManualDnsChallenge, error) {
+	return s.repo.ListPendingByDomain(ctx, req.DomainId)
+}
+
+// 确认域名下所有等待中的 TXT 记录已添加，正在等待的申请会在下次检查时继续。
+func (s *ManualDnsService) Confirm(ctx context.Context, req *domain.ManualDnsChallengeConfirmReq) error {
+	challenges, err := s.repo.ListPendingByDomain(ctx, req.DomainId)
+	if err != nil {
+		return err
+	}
+
+	if len(challenges) == 0 {
+		return errors.New("no pending manual dns challenge")
+	}
+
+	for _, challenge := range challenges {
+		if err := s.repo.UpdateStatus(ctx, challenge.Id, domain.ManualDnsChallengeStatusConfirmed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 服务启动时将超时的记录标记为过期，返回仍有记录在等待的域名，需重新申请以继续等待。
+func (s *ManualDnsService) ListResumable(ctx context.Context) ([]string, error) {
+	if err := s.repo.ExpireOverdue(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListPendingDomainIds(ctx)
+}
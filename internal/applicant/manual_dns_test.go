@@ -0,0 +1,301 @@
+package applicant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+)
+
+type testManualDnsRepository struct {
+	mu         sync.Mutex
+	challenges map[string]*domain.ManualDnsChallenge
+}
+
+func (r *testManualDnsRepository) GetById(ctx context.Context, id string) (*domain.ManualDnsChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	challenge, ok := r.challenges[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	rs := *challenge
+	return &rs, nil
+}
+
+func (r *testManualDnsRepository) ListPendingByDomain(ctx context.Context, domainId string) ([]*domain.ManualDnsChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rs := make([]*domain.ManualDnsChallenge, 0)
+	for _, challenge := range r.challenges {
+		if challenge.DomainId == domainId && challenge.Status == domain.ManualDnsChallengeStatusPending {
+			rs = append(rs, challenge)
+		}
+	}
+
+	return rs, nil
+}
+
+func (r *testManualDnsRepository) GetActiveByValue(ctx context.Context, domainId, fqdn, value string) (*domain.ManualDnsChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, challenge := range r.challenges {
+		if challenge.DomainId == domainId && challenge.Fqdn == fqdn && challenge.Value == value &&
+			challenge.Status != domain.ManualDnsChallengeStatusExpired && time.Now().Before(challenge.ExpiredAt) {
+			rs := *challenge
+			return &rs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *testManualDnsRepository) ListPendingDomainIds(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (r *testManualDnsRepository) Save(ctx context.Context, challenge *domain.ManualDnsChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	challenge.Id = challenge.Identifier
+	saved := *challenge
+	r.challenges[challenge.Id] = &saved
+	return nil
+}
+
+func (r *testManualDnsRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.challenges[id].Status = status
+	return nil
+}
+
+func (r *testManualDnsRepository) ExpirePendingByDomain(ctx context.Context, domainId string) error {
+	return nil
+}
+
+func (r *testManualDnsRepository) ExpireOverdue(ctx context.Context) error {
+	return nil
+}
+
+type testCheckpointLogger struct {
+	*deployer.DefaultLogger
+	checkpoints int
+}
+
+func (l *testCheckpointLogger) Checkpoint() {
+	l.checkpoints++
+}
+
+func startTestTXTServer(t *testing.T, fqdn, value string) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			if r.Question[0].Name == fqdn {
+				m.Answer = append(m.Answer, &dns.TXT{
+					Hdr: dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+					Txt: []string{value},
+				})
+			}
+			w.WriteMsg(m)
+		}),
+	}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+
+	return conn.LocalAddr().String()
+}
+
+func newTestManualDnsProvider(t *testing.T, nameserver string) (*manualDnsProvider, *testManualDnsRepository, *testCheckpointLogger) {
+	repo := &testManualDnsRepository{challenges: make(map[string]*domain.ManualDnsChallenge)}
+	logger := &testCheckpointLogger{DefaultLogger: deployer.NewDefaultLogger()}
+
+	access, _ := json.Marshal(&domain.ManualDnsAccess{ConfirmTimeout: 5})
+	applicant := &manualDnsApplicant{option: &ApplyOption{
+		DomainId:    "domain-id",
+		Access:      string(access),
+		Nameservers: nameserver,
+		Logger:      logger,
+	}}
+
+	provider, err := applicant.newDNSProvider(repo)
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	return provider, repo, logger
+}
+
+func TestManualDnsConfirm(t *testing.T) {
+	// 没有 DNS 服务监听的地址，只能通过确认继续
+	provider, repo, logger := newTestManualDnsProvider(t, "127.0.0.1:1")
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+	if records := strings.Join(logger.GetRecords(), "\n"); !strings.Contains(records, info.Value) {
+		t.Errorf("txt value not logged: %s", records)
+	}
+
+	if ok, err := provider.preCheck("example.com", info.EffectiveFQDN, info.Value, nil); ok || err != nil {
+		t.Fatalf("expected pending, got %v, %v", ok, err)
+	}
+	if logger.checkpoints != 1 {
+		t.Errorf("expected one checkpoint, got %d", logger.checkpoints)
+	}
+
+	svc := &ManualDnsService{repo: repo}
+	if err := svc.Confirm(context.Background(), &domain.ManualDnsChallengeConfirmReq{DomainId: "domain-id"}); err != nil {
+		t.Fatalf("failed to confirm: %v", err)
+	}
+
+	if ok, err := provider.preCheck("example.com", info.EffectiveFQDN, info.Value, nil); !ok || err != nil {
+		t.Fatalf("expected confirmed, got %v, %v", ok, err)
+	}
+	if logger.checkpoints != 1 {
+		t.Errorf("expected one checkpoint, got %d", logger.checkpoints)
+	}
+
+	if err := provider.CleanUp("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if status := repo.challenges["example.com"].Status; status != domain.ManualDnsChallengeStatusConfirmed {
+		t.Errorf("unexpected status after clean up: %s", status)
+	}
+}
+
+func TestManualDnsObserved(t *testing.T) {
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+	nameserver := startTestTXTServer(t, info.EffectiveFQDN, info.Value)
+
+	provider, repo, _ := newTestManualDnsProvider(t, nameserver)
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+
+	if ok, err := provider.preCheck("example.com", info.EffectiveFQDN, info.Value, nil); !ok || err != nil {
+		t.Fatalf("expected observed, got %v, %v", ok, err)
+	}
+	if status := repo.challenges["example.com"].Status; status != domain.ManualDnsChallengeStatusConfirmed {
+		t.Errorf("unexpected status: %s", status)
+	}
+}
+
+func TestManualDnsCleanUpExpiresPending(t *testing.T) {
+	provider, repo, _ := newTestManualDnsProvider(t, "127.0.0.1:1")
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+	if err := provider.CleanUp("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+
+	if status := repo.challenges["example.com"].Status; status != domain.ManualDnsChallengeStatusExpired {
+		t.Errorf("unexpected status: %s", status)
+	}
+
+	svc := &ManualDnsService{repo: repo}
+	if err := svc.Confirm(context.Background(), &domain.ManualDnsChallengeConfirmReq{DomainId: "domain-id"}); err == nil {
+		t.Error("expected error when nothing is pending")
+	}
+}
+
+func TestManualDnsRequiresDomain(t *testing.T) {
+	if _, err := NewManualDns(&ApplyOption{}); err == nil {
+		t.Error("expected error without domain id")
+	}
+}
+
+func TestManualDnsChallengeFqdn(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			if r.Question[0].Name == "_acme-challenge.example.com." {
+				m.Answer = append(m.Answer, &dns.CNAME{
+					Hdr:    dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+					Target: "_acme-challenge.alias.example.net.",
+				})
+			}
+			w.WriteMsg(m)
+		}),
+	}
+	go server.ActivateAndServe()
+	<-started
+	defer server.Shutdown()
+
+	nameservers := []string{conn.LocalAddr().String()}
+	if fqdn := getManualDnsChallengeFqdn("example.com", false, nameservers); fqdn != "_acme-challenge.alias.example.net." {
+		t.Errorf("expected cname target, got %s", fqdn)
+	}
+	if fqdn := getManualDnsChallengeFqdn("example.com", true, nameservers); fqdn != "_acme-challenge.example.com." {
+		t.Errorf("expected original name, got %s", fqdn)
+	}
+}
+
+func TestManualDnsResume(t *testing.T) {
+	provider, repo, logger := newTestManualDnsProvider(t, "127.0.0.1:1")
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+
+	// 重启前已确认的记录
+	repo.challenges["saved"] = &domain.ManualDnsChallenge{
+		Id:         "saved",
+		DomainId:   "domain-id",
+		Identifier: "example.com",
+		Fqdn:       "_acme-challenge.example.com.",
+		Value:      info.Value,
+		Status:     domain.ManualDnsChallengeStatusConfirmed,
+		ExpiredAt:  time.Now().Add(time.Minute),
+	}
+
+	if err := provider.Present("example.com", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+	if len(repo.challenges) != 1 {
+		t.Errorf("expected saved challenge to be reused, got %d", len(repo.challenges))
+	}
+	if records := strings.Join(logger.GetRecords(), "\n"); !strings.Contains(records, "继续等待") {
+		t.Errorf("resume not logged: %s", records)
+	}
+
+	if ok, err := provider.preCheck("example.com", info.EffectiveFQDN, info.Value, nil); !ok || err != nil {
+		t.Fatalf("expected confirmed, got %v, %v", ok, err)
+	}
+}
@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	namesiloProvider "github.com/go-acme/lego/v4/providers/dns/namesilo"
 
 	"certimate/internal/domain"
@@ -21,16 +20,21 @@ func NewNamesilo(option *ApplyOption) Applicant {
 }
 
 func (a *namesilo) Apply() (*Certificate, error) {
-	access := &domain.NameSiloAccess{}
-	json.Unmarshal([]byte(a.option.Access), access)
-
-	os.Setenv("NAMESILO_API_KEY", access.ApiKey)
-	os.Setenv("NAMESILO_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-
-	dnsProvider, err := namesiloProvider.NewDNSProvider()
+	dnsProvider, err := a.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(a.option, dnsProvider)
 }
+
+func (a *namesilo) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.NameSiloAccess{}
+	json.Unmarshal([]byte(a.option.Access), access)
+
+	config := newDefaultConfig(namesiloProvider.NewDefaultConfig)
+	config.APIKey = access.ApiKey
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return namesiloProvider.NewDNSProviderConfig(config)
+}
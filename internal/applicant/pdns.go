@@ -3,8 +3,9 @@ package applicant
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"net/url"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/providers/dns/pdns"
 
 	"certimate/internal/domain"
@@ -21,16 +22,27 @@ func NewPdns(option *ApplyOption) Applicant {
 }
 
 func (a *powerdns) Apply() (*Certificate, error) {
+	dnsProvider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, dnsProvider)
+}
+
+func (a *powerdns) newDNSProvider() (challenge.Provider, error) {
 	access := &domain.PdnsAccess{}
 	json.Unmarshal([]byte(a.option.Access), access)
 
-	os.Setenv("PDNS_API_URL", access.ApiUrl)
-	os.Setenv("PDNS_API_KEY", access.ApiKey)
-	os.Setenv("PDNS_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-	dnsProvider, err := pdns.NewDNSProvider()
+	host, err := url.Parse(access.ApiUrl)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("pdns: %w", err)
 	}
 
-	return apply(a.option, dnsProvider)
+	config := newDefaultConfig(pdns.NewDefaultConfig)
+	config.Host = host
+	config.APIKey = access.ApiKey
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return pdns.NewDNSProviderConfig(config)
 }
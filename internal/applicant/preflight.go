@@ -0,0 +1,531 @@
+package applicant
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+	"github.com/pocketbase/pocketbase/models"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/core/deployer"
+)
+
+// 各 CA 在 CAA 记录中使用的标识。
+var sslProviderCaaIdentities = map[string][]string{
+	sslProviderLetsencrypt: {"letsencrypt.org"},
+	sslProviderZeroSSL:     {"sectigo.com"},
+	sslProviderGts:         {"pki.goog"},
+}
+
+// 签发证书前检查域名解析、CAA 记录、`_acme-challenge` 的 CNAME 及 DNS 服务商授权，
+// 不向 CA 发起请求。
+// DNS-01 质询会通过授权创建并删除一条测试 TXT 记录，与正在进行的申请使用同一名称，
+// 个别服务商删除记录时会清除该名称下的全部 TXT 记录，应避免在申请过程中预检。
+//
+// 入参：
+//   - record: 域名记录。
+//
+// 出参：
+//   - 预检报告。
+//   - 错误。
+func Preflight(record *models.Record) (*domain.DomainPreflightReport, error) {
+	if record.GetString("applyConfig") == "" {
+		return nil, errors.New("applyConfig is empty")
+	}
+
+	applyConfig := &domain.ApplyConfig{}
+	if err := record.UnmarshalJSONField("applyConfig", applyConfig); err != nil {
+		return nil, fmt.Errorf("failed to parse applyConfig: %w", err)
+	}
+
+	sslProvider, err := getSSLProviderConfig(applyConfig.SSLProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &preflight{
+		applyConfig:        applyConfig,
+		sslProvider:        sslProvider,
+		nameservers:        getApplyNameservers(applyConfig.Nameservers),
+		disableFollowCNAME: applyConfig.DisableFollowCNAME,
+		tested:             make(map[string]*domain.PreflightCheck),
+	}
+
+	internal := sslProvider.Provider == sslProviderInternal
+	dnsChallenge := !internal && applyConfig.GetChallengeType() == domain.ChallengeTypeDNS01
+	if dnsChallenge {
+		p.newDNSProvider(record)
+
+		if p.provider != nil {
+			release := dns01Settings.acquire(p.disableFollowCNAME, parseNameservers(applyConfig.Nameservers))
+			defer release()
+		}
+	}
+
+	report := &domain.DomainPreflightReport{
+		Domain:        record.GetString("domain"),
+		ChallengeType: applyConfig.GetChallengeType(),
+		SSLProvider:   sslProvider.Provider,
+		Nameservers:   p.nameservers,
+		Passed:        true,
+		Names:         make([]*domain.PreflightNameReport, 0),
+	}
+	if internal {
+		report.ChallengeType = ""
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(record.GetString("domain"), ";") {
+		name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		nameReport := &domain.PreflightNameReport{Name: name}
+		if net.ParseIP(name) != nil {
+			nameReport.Checks = append(nameReport.Checks,
+				newPreflightSkip(domain.PreflightCheckResolve, "ip address does not need to be resolved"),
+				newPreflightSkip(domain.PreflightCheckCAA, "caa records do not apply to ip addresses"),
+			)
+		} else {
+			nameReport.Checks = append(nameReport.Checks, p.checkResolve(name, internal || dnsChallenge))
+		}
+
+		if net.ParseIP(name) != nil && dnsChallenge {
+			nameReport.Checks = append(nameReport.Checks,
+				&domain.PreflightCheck{Type: domain.PreflightCheckCNAME, Status: domain.PreflightStatusFail, Message: "ip address cannot be validated with dns-01 challenge"},
+				newPreflightSkip(domain.PreflightCheckTXT, "ip address cannot be validated with dns-01 challenge"),
+			)
+		} else if net.ParseIP(name) != nil {
+			nameReport.Checks = append(nameReport.Checks,
+				newPreflightSkip(domain.PreflightCheckCNAME, "only required for dns-01 challenge"),
+				newPreflightSkip(domain.PreflightCheckTXT, "only required for dns-01 challenge"),
+			)
+		} else if internal {
+			nameReport.Checks = append(nameReport.Checks,
+				newPreflightSkip(domain.PreflightCheckCAA, "internal ca does not check caa records"),
+				newPreflightSkip(domain.PreflightCheckCNAME, "internal ca does not validate domains"),
+				newPreflightSkip(domain.PreflightCheckTXT, "internal ca does not validate domains"),
+			)
+		} else if dnsChallenge {
+			nameReport.Checks = append(nameReport.Checks, p.checkCAA(name), p.checkCNAME(name), p.checkTXT(name))
+		} else {
+			nameReport.Checks = append(nameReport.Checks,
+				p.checkCAA(name),
+				newPreflightSkip(domain.PreflightCheckCNAME, "only required for dns-01 challenge"),
+				newPreflightSkip(domain.PreflightCheckTXT, "only required for dns-01 challenge"),
+			)
+		}
+
+		for _, check := range nameReport.Checks {
+			if check.Status == domain.PreflightStatusFail {
+				report.Passed = false
+			}
+		}
+
+		report.Names = append(report.Names, nameReport)
+	}
+
+	return report, nil
+}
+
+type preflight struct {
+	applyConfig        *domain.ApplyConfig
+	sslProvider        *SSLProviderConfig
+	nameservers        []string
+	disableFollowCNAME bool
+
+	provider    challenge.Provider
+	providerErr error
+
+	// 通配符域名与其主域名使用同一条 TXT 记录，只测试一次。
+	tested map[string]*domain.PreflightCheck
+}
+
+func (p *preflight) newDNSProvider(record *models.Record) {
+	option := &ApplyOption{
+		DomainId:           record.Id,
+		Domain:             record.GetString("domain"),
+		Nameservers:        p.applyConfig.Nameservers,
+		Timeout:            p.applyConfig.Timeout,
+		DisableFollowCNAME: p.applyConfig.DisableFollowCNAME,
+		Logger:             deployer.NewNilLogger(),
+	}
+	if option.Timeout == 0 {
+		option.Timeout = defaultTimeout
+	}
+
+	applicant, err := getWithDNS01(p.applyConfig, option)
+	if err != nil {
+		p.providerErr = err
+		return
+	}
+
+	// 别名模式会强制跟随 CNAME
+	p.disableFollowCNAME = option.DisableFollowCNAME
+
+	providerApplicant, ok := applicant.(dnsProviderApplicant)
+	if !ok {
+		return
+	}
+
+	provider, err := providerApplicant.newDNSProvider()
+	if err != nil {
+		p.providerErr = err
+		return
+	}
+
+	p.provider = provider
+}
+
+func (p *preflight) checkResolve(name string, optional bool) *domain.PreflightCheck {
+	if strings.HasPrefix(name, "*.") {
+		return newPreflightSkip(domain.PreflightCheckResolve, "wildcard names are not resolved")
+	}
+
+	failStatus := domain.PreflightStatusFail
+	if optional {
+		failStatus = domain.PreflightStatusWarn
+	}
+
+	records := make([]string, 0)
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		in, err := queryDNS(p.nameservers, dns.Fqdn(name), qtype)
+		if err != nil {
+			return &domain.PreflightCheck{
+				Type:    domain.PreflightCheckResolve,
+				Status:  failStatus,
+				Message: fmt.Sprintf("failed to resolve %s: %v", name, err),
+			}
+		}
+
+		for _, rr := range in.Answer {
+			switch rr := rr.(type) {
+			case *dns.A:
+				records = append(records, "A "+rr.A.String())
+			case *dns.AAAA:
+				records = append(records, "AAAA "+rr.AAAA.String())
+			case *dns.CNAME:
+				if qtype == dns.TypeA {
+					records = append(records, fmt.Sprintf("CNAME %s -> %s", rr.Hdr.Name, rr.Target))
+				}
+			}
+		}
+	}
+
+	if len(records) == 0 {
+		message := fmt.Sprintf("%s has no A or AAAA records", name)
+		if optional {
+			message += ", which is not required for validation"
+		}
+
+		return &domain.PreflightCheck{Type: domain.PreflightCheckResolve, Status: failStatus, Message: message}
+	}
+
+	return &domain.PreflightCheck{
+		Type:    domain.PreflightCheckResolve,
+		Status:  domain.PreflightStatusPass,
+		Message: fmt.Sprintf("%s resolves", name),
+		Records: records,
+	}
+}
+
+// 按 RFC 8659 自下而上查找第一个存在 CAA 记录的域名，检查首选 CA 及备用 CA 是否被允许签发。
+func (p *preflight) checkCAA(name string) *domain.PreflightCheck {
+	wildcard := strings.HasPrefix(name, "*.")
+
+	records, owner, err := lookupCAA(p.nameservers, strings.TrimPrefix(name, "*."))
+	if err != nil {
+		// CA 无法查询 CAA 记录时会拒绝签发
+		return &domain.PreflightCheck{
+			Type:    domain.PreflightCheckCAA,
+			Status:  domain.PreflightStatusFail,
+			Message: fmt.Sprintf("failed to query caa records: %v", err),
+		}
+	}
+
+	if len(records) == 0 {
+		return &domain.PreflightCheck{
+			Type:    domain.PreflightCheckCAA,
+			Status:  domain.PreflightStatusPass,
+			Message: "no caa records, any ca is allowed to issue",
+		}
+	}
+
+	check := &domain.PreflightCheck{
+		Type:    domain.PreflightCheckCAA,
+		Status:  domain.PreflightStatusPass,
+		Records: make([]string, 0, len(records)),
+	}
+	for _, record := range records {
+		check.Records = append(check.Records, fmt.Sprintf("%s CAA %d %s %q", owner, record.Flag, record.Tag, record.Value))
+	}
+
+	messages := make([]string, 0)
+	for i, provider := range getSSLProviderCandidates(p.sslProvider, p.applyConfig.SSLProviderFallbacks) {
+		candidate := *p.sslProvider
+		candidate.Provider = provider
+
+		status := domain.PreflightStatusFail
+		if i > 0 {
+			// 备用 CA 被禁止时仍可使用首选 CA 签发
+			status = domain.PreflightStatusWarn
+		}
+
+		identities, err := getSSLProviderCaaIdentities(&candidate)
+		if err != nil || len(identities) == 0 {
+			messages = append(messages, fmt.Sprintf("unable to determine caa identities of %s, please check the records manually", provider))
+			check.Status = worsePreflightStatus(check.Status, domain.PreflightStatusWarn)
+			continue
+		}
+
+		if allowed, reason := evaluateCAA(records, identities, wildcard); !allowed {
+			messages = append(messages, fmt.Sprintf("%s is not allowed to issue: %s", provider, reason))
+			check.Status = worsePreflightStatus(check.Status, status)
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s is allowed to issue", provider))
+	}
+
+	check.Message = strings.Join(messages, "; ")
+	return check
+}
+
+func (p *preflight) checkCNAME(name string) *domain.PreflightCheck {
+	name = normalizeDnsRouteDomain(name)
+	fqdn := dns.Fqdn("_acme-challenge." + name)
+
+	target, err := lookupCNAME(p.nameservers, fqdn)
+	if err != nil {
+		return &domain.PreflightCheck{
+			Type:    domain.PreflightCheckCNAME,
+			Status:  domain.PreflightStatusFail,
+			Message: fmt.Sprintf("failed to resolve cname of %s: %v", fqdn, err),
+		}
+	}
+
+	check := &domain.PreflightCheck{Type: domain.PreflightCheckCNAME, Status: domain.PreflightStatusPass}
+	if target != "" {
+		check.Records = []string{fmt.Sprintf("%s CNAME %s", fqdn, target)}
+	}
+
+	for _, alias := range p.applyConfig.DnsAliases {
+		if normalizeDnsRouteDomain(alias.Domain) != name {
+			continue
+		}
+
+		if !strings.EqualFold(target, dns.Fqdn(alias.AliasDomain)) {
+			check.Status = domain.PreflightStatusFail
+			check.Message = fmt.Sprintf("%s should be a cname to %s, but got %q", fqdn, dns.Fqdn(alias.AliasDomain), target)
+			return check
+		}
+
+		check.Message = fmt.Sprintf("%s is a cname to alias %s", fqdn, target)
+		return check
+	}
+
+	switch {
+	case target == "":
+		check.Message = fmt.Sprintf("%s has no cname, the txt record will be created in its own zone", fqdn)
+	case p.disableFollowCNAME:
+		// TXT 记录不能与 CNAME 记录共存
+		check.Status = domain.PreflightStatusFail
+		check.Message = fmt.Sprintf("%s is a cname to %s, but following cname is disabled", fqdn, target)
+	default:
+		check.Message = fmt.Sprintf("%s is a cname to %s, the txt record will be created there", fqdn, target)
+	}
+
+	return check
+}
+
+func (p *preflight) checkTXT(name string) *domain.PreflightCheck {
+	name = normalizeDnsRouteDomain(name)
+	if check, ok := p.tested[name]; ok {
+		return check
+	}
+
+	check := p.testTXT(name)
+	p.tested[name] = check
+	return check
+}
+
+func (p *preflight) testTXT(name string) *domain.PreflightCheck {
+	if p.providerErr != nil {
+		return &domain.PreflightCheck{
+			Type:    domain.PreflightCheckTXT,
+			Status:  domain.PreflightStatusFail,
+			Message: fmt.Sprintf("failed to create dns provider: %v", p.providerErr),
+		}
+	}
+	if p.provider == nil {
+		return newPreflightSkip(domain.PreflightCheckTXT, "manual dns access cannot be tested")
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return &domain.PreflightCheck{Type: domain.PreflightCheckTXT, Status: domain.PreflightStatusFail, Message: err.Error()}
+	}
+
+	token := "certimate-preflight"
+	keyAuth := token + "." + hex.EncodeToString(nonce)
+	info := dns01.GetChallengeInfo(name, keyAuth)
+
+	check := &domain.PreflightCheck{
+		Type:    domain.PreflightCheckTXT,
+		Records: []string{fmt.Sprintf("%s TXT %q", info.EffectiveFQDN, info.Value)},
+	}
+
+	if err := p.provider.Present(name, token, keyAuth); err != nil {
+		check.Status = domain.PreflightStatusFail
+		check.Message = fmt.Sprintf("failed to create test txt record: %v", err)
+		return check
+	}
+
+	if err := p.provider.CleanUp(name, token, keyAuth); err != nil {
+		check.Status = domain.PreflightStatusWarn
+		check.Message = fmt.Sprintf("test txt record was created but could not be deleted, please remove it manually: %v", err)
+		return check
+	}
+
+	check.Status = domain.PreflightStatusPass
+	check.Message = "test txt record was created and deleted"
+	return check
+}
+
+// 从指定域名开始逐级向上查找 CAA 记录，返回第一个存在记录的域名及其记录。
+func lookupCAA(nameservers []string, name string) ([]*dns.CAA, string, error) {
+	labels := dns.SplitDomainName(name)
+	for i := range labels {
+		fqdn := dns.Fqdn(strings.Join(labels[i:], "."))
+
+		in, err := queryDNS(nameservers, fqdn, dns.TypeCAA)
+		if err != nil {
+			return nil, fqdn, err
+		}
+
+		records := make([]*dns.CAA, 0)
+		for _, rr := range in.Answer {
+			if caa, ok := rr.(*dns.CAA); ok {
+				records = append(records, caa)
+			}
+		}
+
+		if len(records) > 0 {
+			return records, fqdn, nil
+		}
+	}
+
+	return nil, "", nil
+}
+
+// 判断 CAA 记录集合是否允许持有任一标识的 CA 签发证书。
+//
+// 入参：
+//   - records: 同一域名下的 CAA 记录。
+//   - identities: CA 的 CAA 标识。
+//   - wildcard: 是否为通配符证书，存在 issuewild 记录时仅以其为准。
+//
+// 出参：
+//   - 是否允许。
+//   - 不允许的原因。
+func evaluateCAA(records []*dns.CAA, identities []string, wildcard bool) (bool, string) {
+	knownTags := map[string]bool{"issue": true, "issuewild": true, "iodef": true, "contactemail": true, "contactphone": true, "issuemail": true, "issuevmc": true}
+
+	tag := "issue"
+	for _, record := range records {
+		if record.Flag&128 != 0 && !knownTags[strings.ToLower(record.Tag)] {
+			return false, fmt.Sprintf("unknown critical property %q", record.Tag)
+		}
+
+		if wildcard && strings.EqualFold(record.Tag, "issuewild") {
+			tag = "issuewild"
+		}
+	}
+
+	restricted := false
+	for _, record := range records {
+		if !strings.EqualFold(record.Tag, tag) {
+			continue
+		}
+		restricted = true
+
+		issuer := strings.TrimSpace(strings.SplitN(record.Value, ";", 2)[0])
+		for _, identity := range identities {
+			if strings.EqualFold(issuer, identity) {
+				return true, ""
+			}
+		}
+	}
+
+	if !restricted {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("%s records do not include %s", tag, strings.Join(identities, ", "))
+}
+
+// 获取 CA 的 CAA 标识，自定义 CA 从 ACME 目录的 meta.caaIdentities 中读取。
+func getSSLProviderCaaIdentities(sslProvider *SSLProviderConfig) ([]string, error) {
+	if identities, ok := sslProviderCaaIdentities[sslProvider.Provider]; ok {
+		return identities, nil
+	}
+
+	caDirUrl, err := getSSLProviderUrl(sslProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	if sslProvider.Config.Custom.CaCertificates != "" {
+		client, err = newSSLProviderHttpClient(sslProvider.Config.Custom.CaCertificates, client.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	directory, err := getSSLProviderDirectory(client, caDirUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return directory.Meta.CaaIdentities, nil
+}
+
+// 未配置 DNS 服务器时使用系统默认。
+func getApplyNameservers(ns string) []string {
+	nameservers := parseNameservers(ns)
+	if len(nameservers) == 0 {
+		return getDefaultNameservers()
+	}
+
+	return dns01.ParseNameservers(nameservers)
+}
+
+func newPreflightSkip(checkType, message string) *domain.PreflightCheck {
+	return &domain.PreflightCheck{Type: checkType, Status: domain.PreflightStatusSkip, Message: message}
+}
+
+func worsePreflightStatus(a, b string) string {
+	rank := map[string]int{
+		domain.PreflightStatusSkip: 0,
+		domain.PreflightStatusPass: 1,
+		domain.PreflightStatusWarn: 2,
+		domain.PreflightStatusFail: 3,
+	}
+	if rank[b] > rank[a] {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,79 @@
+package applicant
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestEvaluateCAA(t *testing.T) {
+	issue := func(value string) *dns.CAA { return &dns.CAA{Tag: "issue", Value: value} }
+	issuewild := func(value string) *dns.CAA { return &dns.CAA{Tag: "issuewild", Value: value} }
+
+	tests := []struct {
+		name     string
+		records  []*dns.CAA
+		wildcard bool
+		want     bool
+	}{
+		{"allowed", []*dns.CAA{issue("pki.goog"), issue("letsencrypt.org")}, false, true},
+		{"allowed with parameters", []*dns.CAA{issue("letsencrypt.org; validationmethods=dns-01")}, false, true},
+		{"denied", []*dns.CAA{issue("sectigo.com")}, false, false},
+		{"denied all", []*dns.CAA{issue(";")}, false, false},
+		{"iodef only", []*dns.CAA{{Tag: "iodef", Value: "mailto:admin@example.com"}}, false, true},
+		{"issuewild ignored", []*dns.CAA{issue("letsencrypt.org"), issuewild(";")}, false, true},
+		{"issuewild denied", []*dns.CAA{issue("letsencrypt.org"), issuewild(";")}, true, false},
+		{"issue for wildcard", []*dns.CAA{issue("letsencrypt.org")}, true, true},
+		{"unknown critical", []*dns.CAA{issue("letsencrypt.org"), {Flag: 128, Tag: "tbs", Value: "x"}}, false, false},
+	}
+
+	for _, tt := range tests {
+		if got, reason := evaluateCAA(tt.records, []string{"letsencrypt.org"}, tt.wildcard); got != tt.want {
+			t.Errorf("%s: got %v (%s), want %v", tt.name, got, reason, tt.want)
+		}
+	}
+}
+
+func TestLookupCAA(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			if r.Question[0].Name == "example.com." && r.Question[0].Qtype == dns.TypeCAA {
+				m.Answer = append(m.Answer, &dns.CAA{
+					Hdr:   dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeCAA, Class: dns.ClassINET, Ttl: 60},
+					Tag:   "issue",
+					Value: "letsencrypt.org",
+				})
+			}
+			w.WriteMsg(m)
+		}),
+	}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+
+	nameservers := []string{conn.LocalAddr().String()}
+
+	records, owner, err := lookupCAA(nameservers, "a.b.example.com")
+	if err != nil {
+		t.Fatalf("failed to lookup caa: %v", err)
+	}
+	if owner != "example.com." || len(records) != 1 || records[0].Value != "letsencrypt.org" {
+		t.Errorf("unexpected caa records of %s: %v", owner, records)
+	}
+
+	records, _, err = lookupCAA(nameservers, "example.net")
+	if err != nil || len(records) != 0 {
+		t.Errorf("unexpected caa records: %v, %v", records, err)
+	}
+}
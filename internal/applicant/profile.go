@@ -0,0 +1,172 @@
+package applicant
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-jose/go-jose/v4"
+)
+
+// 创建申请证书使用的 ACME 客户端，指定证书配置文件（如 Let's Encrypt 的 "shortlived"、"tlsserver"）时，
+// 在 new-order 请求中加入 profile 字段（draft-ietf-acme-profiles）。
+//
+// 入参：
+//   - sslProvider: CA 配置。
+//   - email: 联系邮箱。
+//   - keyAlgorithm: 证书私钥算法。
+//   - profile: 证书配置文件，为空时使用 CA 的默认配置。
+//
+// 出参：
+//   - ACME 客户端。
+//   - ACME 账户。
+//   - 错误。
+func newClientWithProfile(sslProvider *SSLProviderConfig, email string, keyAlgorithm string, profile string) (*lego.Client, *ApplyUser, error) {
+	if profile == "" {
+		return newClient(sslProvider, email, keyAlgorithm)
+	}
+
+	caDirUrl, err := getSSLProviderUrl(sslProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	myUser, err := newApplyUser(getSSLProviderAccountCA(sslProvider), email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := newClientConfig(sslProvider, caDirUrl, myUser, keyAlgorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	directory, err := getSSLProviderDirectory(config.HTTPClient, caDirUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := directory.Meta.Profiles[profile]; !ok {
+		profiles := make([]string, 0, len(directory.Meta.Profiles))
+		for name := range directory.Meta.Profiles {
+			profiles = append(profiles, name)
+		}
+		slices.Sort(profiles)
+
+		return nil, nil, fmt.Errorf("ssl provider %s does not support profile %q, available profiles: [%s]", sslProvider.Provider, profile, strings.Join(profiles, ", "))
+	}
+
+	signer, ok := myUser.GetPrivateKey().(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.New("unsupported acme account key")
+	}
+
+	httpClient := *config.HTTPClient
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = &acmeProfileTransport{
+		base:        transport,
+		newOrderUrl: directory.NewOrder,
+		profile:     profile,
+		key:         signer,
+	}
+	config.HTTPClient = &httpClient
+
+	client, err := lego.NewClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, myUser, nil
+}
+
+// lego 暂不支持证书配置文件，在发送 new-order 请求前向载荷中加入 profile 字段，并使用账户私钥重新签名。
+// 受保护头部（含 nonce、kid 及 url）保持不变。
+type acmeProfileTransport struct {
+	base        http.RoundTripper
+	newOrderUrl string
+	profile     string
+	key         crypto.Signer
+}
+
+func (t *acmeProfileTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || req.URL.String() != t.newOrderUrl || req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err = addAcmeOrderProfile(body, t.profile, t.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add profile to new-order request: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// 向 JWS 载荷中加入 profile 字段，并使用原受保护头部中的 kid、nonce 及 url 重新签名。
+func addAcmeOrderProfile(body []byte, profile string, key crypto.Signer) ([]byte, error) {
+	alg, err := getSignatureAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	jws, err := jose.ParseSigned(string(body), []jose.SignatureAlgorithm{alg})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jws.Verify(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]any)
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	payload["profile"] = profile
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	header := jws.Signatures[0].Protected
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: jose.JSONWebKey{Key: key, KeyID: header.KeyID}}, &jose.SignerOptions{
+		ExtraHeaders: map[jose.HeaderKey]any{
+			jose.HeaderKey("nonce"): header.Nonce,
+			jose.HeaderKey("url"):   header.ExtraHeaders[jose.HeaderKey("url")],
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := signer.Sign(payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(signed.FullSerialize()), nil
+}
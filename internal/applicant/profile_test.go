@@ -0,0 +1,126 @@
+package applicant
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+func TestAddAcmeOrderProfile(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		alg jose.SignatureAlgorithm
+		key crypto.Signer
+	}{
+		{jose.ES256, ecKey},
+		{jose.RS256, rsaKey},
+	}
+
+	for _, tt := range tests {
+		body := newTestJws(t, tt.alg, tt.key, `{"identifiers":[{"type":"dns","value":"example.com"}]}`)
+
+		rs, err := addAcmeOrderProfile(body, "shortlived", tt.key)
+		if err != nil {
+			t.Fatalf("%s: failed to add profile: %v", tt.alg, err)
+		}
+
+		jws, err := jose.ParseSigned(string(rs), []jose.SignatureAlgorithm{tt.alg})
+		if err != nil {
+			t.Fatalf("%s: failed to parse jws: %v", tt.alg, err)
+		}
+		protected := jws.Signatures[0].Protected
+		if protected.Nonce != "test-nonce" || protected.KeyID != "https://ca.example.com/acct/1" || protected.ExtraHeaders["url"] != "https://ca.example.com/new-order" {
+			t.Errorf("%s: protected header should not be changed", tt.alg)
+		}
+
+		payload, err := jws.Verify(tt.key.Public())
+		if err != nil {
+			t.Fatalf("%s: failed to verify jws: %v", tt.alg, err)
+		}
+
+		order := struct {
+			Identifiers []map[string]string `json:"identifiers"`
+			Profile     string              `json:"profile"`
+		}{}
+		if err := json.Unmarshal(payload, &order); err != nil {
+			t.Fatal(err)
+		}
+		if order.Profile != "shortlived" || len(order.Identifiers) != 1 {
+			t.Errorf("%s: unexpected order payload: %s", tt.alg, payload)
+		}
+	}
+}
+
+func TestAcmeProfileTransport(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bodies := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies[r.URL.Path], _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &acmeProfileTransport{
+		base:        http.DefaultTransport,
+		newOrderUrl: server.URL + "/new-order",
+		profile:     "shortlived",
+		key:         key,
+	}}
+
+	body := newTestJws(t, jose.ES256, key, `{"identifiers":[]}`)
+	for _, path := range []string{"/new-order", "/new-account"} {
+		res, err := client.Post(server.URL+path, "application/jose+json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("failed to post: %v", err)
+		}
+		res.Body.Close()
+	}
+
+	if bytes.Equal(bodies["/new-order"], body) {
+		t.Error("new-order request should be rewritten")
+	}
+	if !bytes.Equal(bodies["/new-account"], body) {
+		t.Error("other requests should not be rewritten")
+	}
+}
+
+func newTestJws(t *testing.T, alg jose.SignatureAlgorithm, key crypto.Signer, payload string) []byte {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, &jose.SignerOptions{
+		ExtraHeaders: map[jose.HeaderKey]any{
+			"nonce": "test-nonce",
+			"url":   "https://ca.example.com/new-order",
+			"kid":   "https://ca.example.com/acct/1",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jws, err := signer.Sign([]byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return []byte(jws.FullSerialize())
+}
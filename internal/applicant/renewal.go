@@ -0,0 +1,63 @@
+package applicant
+
+import (
+	"errors"
+
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/pocketbase/pocketbase/models"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
+)
+
+// 向签发证书的 CA 查询 ARI（RFC 9773）建议的续期时间窗口。
+// CA 不支持 ARI 时返回 api.ErrNoARI。
+//
+// 入参：
+//   - record: 域名记录。
+//
+// 出参：
+//   - ARI 查询结果。
+//   - 错误。
+func GetRenewalInfo(record *models.Record) (*certificate.RenewalInfoResponse, error) {
+	certPem := record.GetString("certificate")
+	if certPem == "" {
+		return nil, errors.New("certificate is empty")
+	}
+
+	cert, err := x509.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	applyConfig := &domain.ApplyConfig{}
+	record.UnmarshalJSONField("applyConfig", applyConfig)
+
+	// 优先使用实际签发该证书的 CA，早期记录中没有 CA 信息时使用当前配置的 CA
+	ca := record.GetString("ca")
+	if ca == "" {
+		ca, err = GetCA(applyConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 内置 CA 不支持 ARI
+	if ca == sslProviderInternal {
+		return nil, api.ErrNoARI
+	}
+
+	sslProvider, err := getSSLProviderConfigByCA(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	// ARI 查询不需要账户签名，未配置联系邮箱时也可以查询
+	client, _, err := newClient(sslProvider, applyConfig.Email, applyConfig.KeyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Certificate.GetRenewalInfo(certificate.RenewalInfoRequest{Cert: cert})
+}
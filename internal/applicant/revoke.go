@@ -0,0 +1,85 @@
+package applicant
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/pocketbase/pocketbase/models"
+
+	"certimate/internal/domain"
+)
+
+// 向签发证书的 CA 吊销证书。
+// 吊销请求使用签发该证书的 ACME 账户签名，因此账户必须已经注册过。
+//
+// 入参：
+//   - record: 域名记录。
+//   - certPem: 待吊销的 PEM 格式证书。
+//   - ca: 签发该证书的 CA，为空时使用域名当前配置的 CA。
+//   - reason: RFC 5280 定义的吊销原因代码。
+//
+// 出参：
+//   - 错误。
+func Revoke(record *models.Record, certPem string, ca string, reason uint) error {
+	if certPem == "" {
+		return errors.New("certificate is empty")
+	}
+
+	if !isValidRevocationReason(reason) {
+		return fmt.Errorf("invalid revocation reason: %d", reason)
+	}
+
+	applyConfig := &domain.ApplyConfig{}
+	record.UnmarshalJSONField("applyConfig", applyConfig)
+
+	if ca == "" {
+		var err error
+		ca, err = GetCA(applyConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ca == sslProviderInternal {
+		return errors.New("certificates issued by the internal ca cannot be revoked")
+	}
+
+	if applyConfig.Email == "" {
+		return errEmailRequired
+	}
+
+	sslProvider, err := getSSLProviderConfigByCA(ca)
+	if err != nil {
+		return err
+	}
+
+	client, myUser, err := newClient(sslProvider, applyConfig.Email, applyConfig.KeyAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	if !myUser.hasRegistration() {
+		return fmt.Errorf("acme account %s is not registered on %s", applyConfig.Email, ca)
+	}
+
+	return client.Certificate.RevokeWithReason([]byte(certPem), &reason)
+}
+
+func isValidRevocationReason(reason uint) bool {
+	switch reason {
+	case acme.CRLReasonUnspecified,
+		acme.CRLReasonKeyCompromise,
+		acme.CRLReasonCACompromise,
+		acme.CRLReasonAffiliationChanged,
+		acme.CRLReasonSuperseded,
+		acme.CRLReasonCessationOfOperation,
+		acme.CRLReasonCertificateHold,
+		acme.CRLReasonRemoveFromCRL,
+		acme.CRLReasonPrivilegeWithdrawn,
+		acme.CRLReasonAACompromise:
+		return true
+	}
+
+	return false
+}
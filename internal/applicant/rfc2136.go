@@ -0,0 +1,43 @@
+package applicant
+
+import (
+	"encoding/json"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns/rfc2136"
+
+	"certimate/internal/domain"
+)
+
+type rfc2136Applicant struct {
+	option *ApplyOption
+}
+
+func NewRfc2136(option *ApplyOption) Applicant {
+	return &rfc2136Applicant{
+		option: option,
+	}
+}
+
+func (a *rfc2136Applicant) Apply() (*Certificate, error) {
+	dnsProvider, err := a.newDNSProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return apply(a.option, dnsProvider)
+}
+
+func (a *rfc2136Applicant) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.Rfc2136Access{}
+	json.Unmarshal([]byte(a.option.Access), access)
+
+	config := newDefaultConfig(rfc2136.NewDefaultConfig)
+	config.Nameserver = access.Nameserver
+	config.TSIGKey = access.TsigKey
+	config.TSIGAlgorithm = access.TsigAlgorithm
+	config.TSIGSecret = access.TsigSecret
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return rfc2136.NewDNSProviderConfig(config)
+}
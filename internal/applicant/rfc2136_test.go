@@ -0,0 +1,149 @@
+package applicant
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+
+	"certimate/internal/domain"
+)
+
+const (
+	testRfc2136Zone    = "example.test."
+	testRfc2136TsigKey = "certimate."
+)
+
+var testRfc2136TsigSecret = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+type testRfc2136Server struct {
+	mu      sync.Mutex
+	records map[string][]string
+}
+
+func (s *testRfc2136Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+
+	switch {
+	case r.Opcode == dns.OpcodeUpdate:
+		if r.IsTsig() == nil || w.TsigStatus() != nil {
+			m.Rcode = dns.RcodeNotAuth
+			break
+		}
+
+		s.mu.Lock()
+		for _, rr := range r.Ns {
+			if txt, ok := rr.(*dns.TXT); ok && txt.Hdr.Class == dns.ClassINET {
+				s.records[txt.Hdr.Name] = append(s.records[txt.Hdr.Name], txt.Txt...)
+			}
+		}
+		s.mu.Unlock()
+
+	case len(r.Question) == 1 && r.Question[0].Qtype == dns.TypeSOA:
+		if r.Question[0].Name != testRfc2136Zone {
+			m.Rcode = dns.RcodeNameError
+			break
+		}
+
+		m.Answer = append(m.Answer, &dns.SOA{
+			Hdr:     dns.RR_Header{Name: testRfc2136Zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 60},
+			Ns:      "ns1." + testRfc2136Zone,
+			Mbox:    "admin." + testRfc2136Zone,
+			Serial:  1,
+			Refresh: 60,
+		})
+
+	default:
+		m.Rcode = dns.RcodeNameError
+	}
+
+	if r.IsTsig() != nil {
+		m.SetTsig(testRfc2136TsigKey, dns.HmacSHA256, 300, int64(r.IsTsig().TimeSigned))
+	}
+
+	w.WriteMsg(m)
+}
+
+func startTestRfc2136Server(t *testing.T) (*testRfc2136Server, string) {
+	handler := &testRfc2136Server{records: make(map[string][]string)}
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		Handler:           handler,
+		TsigSecret:        map[string]string{testRfc2136TsigKey: testRfc2136TsigSecret},
+		NotifyStartedFunc: func() { close(started) },
+		// 默认只接受查询，动态更新需要单独放行
+		MsgAcceptFunc: func(dh dns.Header) dns.MsgAcceptAction {
+			if int(dh.Bits>>11)&0xF == dns.OpcodeUpdate {
+				return dns.MsgAccept
+			}
+			return dns.DefaultMsgAcceptFunc(dh)
+		},
+	}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+
+	return handler, conn.LocalAddr().String()
+}
+
+func newTestRfc2136Applicant(nameserver, secret string) *rfc2136Applicant {
+	access, _ := json.Marshal(&domain.Rfc2136Access{
+		Nameserver:    nameserver,
+		TsigKey:       "certimate",
+		TsigAlgorithm: "hmac-sha256",
+		TsigSecret:    secret,
+	})
+	return &rfc2136Applicant{option: &ApplyOption{Access: string(access), Timeout: 60}}
+}
+
+func TestRfc2136Present(t *testing.T) {
+	server, addr := startTestRfc2136Server(t)
+
+	release := dns01Settings.acquire(true, []string{addr})
+	defer release()
+
+	provider, err := newTestRfc2136Applicant(addr, testRfc2136TsigSecret).newDNSProvider()
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	if err := provider.Present("www.example.test", "token", "keyAuth"); err != nil {
+		t.Fatalf("failed to present: %v", err)
+	}
+
+	info := dns01.GetChallengeInfo("www.example.test", "keyAuth")
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if values := server.records[info.FQDN]; len(values) != 1 || values[0] != info.Value {
+		t.Errorf("unexpected txt records for %s: %v", info.FQDN, values)
+	}
+}
+
+func TestRfc2136PresentWithWrongSecret(t *testing.T) {
+	_, addr := startTestRfc2136Server(t)
+
+	release := dns01Settings.acquire(true, []string{addr})
+	defer release()
+
+	wrongSecret := base64.StdEncoding.EncodeToString([]byte("wrong secret"))
+	provider, err := newTestRfc2136Applicant(addr, wrongSecret).newDNSProvider()
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	if err := provider.Present("www.example.test", "token", "keyAuth"); err == nil {
+		t.Error("expected error for wrong tsig secret")
+	}
+}
@@ -0,0 +1,281 @@
+package applicant
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+)
+
+const (
+	acmeErrorRateLimited    = "urn:ietf:params:acme:error:rateLimited"
+	acmeErrorServerInternal = "urn:ietf:params:acme:error:serverInternal"
+)
+
+// 获取 CA 配置。
+// 全局配置来自 settings 中名为 ssl-provider 的记录，provider 非空时将覆盖全局配置中选择的 CA。
+func getSSLProviderConfig(provider string) (*SSLProviderConfig, error) {
+	record, _ := app.GetApp().Dao().FindFirstRecordByFilter("settings", "name='ssl-provider'")
+
+	sslProvider := &SSLProviderConfig{
+		Config:   SSLProviderConfigContent{},
+		Provider: defaultSSLProvider,
+	}
+	if record != nil {
+		if err := record.UnmarshalJSONField("content", sslProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	if provider != "" {
+		sslProvider.Provider = provider
+	}
+
+	return sslProvider, nil
+}
+
+// 根据 acme_accounts 中的 CA 标识获取 CA 配置，用于与签发某张证书的 CA 通信。
+func getSSLProviderConfigByCA(ca string) (*SSLProviderConfig, error) {
+	sslProvider, err := getSSLProviderConfig("")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := sslProviderUrls[ca]; ok {
+		sslProvider.Provider = ca
+		return sslProvider, nil
+	}
+
+	// 自定义 CA 的测试环境沿用其根证书及 EAB 配置
+	if sslProvider.Config.Custom.StagingUrl != "" && sslProvider.Config.Custom.StagingUrl == ca {
+		sslProvider.Config.Custom.Url = ca
+	} else if sslProvider.Config.Custom.Url != ca {
+		sslProvider.Config.Custom = SSLProviderCustom{Url: ca}
+	}
+	sslProvider.Provider = sslProviderCustom
+
+	return sslProvider, nil
+}
+
+// 获取域名申请证书时将使用的 CA 标识，与证书申请成功后记录在域名上的标识一致。
+//
+// 入参：
+//   - applyConfig: 域名的申请配置。
+//
+// 出参：
+//   - CA 标识。
+//   - 错误。
+func GetCA(applyConfig *domain.ApplyConfig) (string, error) {
+	sslProvider, err := getSSLProviderConfig(applyConfig.SSLProvider)
+	if err != nil {
+		return "", err
+	}
+
+	if applyConfig.Staging && sslProvider.Provider != sslProviderInternal {
+		sslProvider, err = getSSLProviderStagingConfig(sslProvider)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return getSSLProviderAccountCA(sslProvider), nil
+}
+
+// 获取域名申请证书时可能使用的全部 CA 标识，依次为主 CA 及备用 CA。
+// 主 CA 不可用时证书会由备用 CA 签发，判断 CA 是否变更时用于确认签发证书的备用 CA 仍在配置中。
+//
+// 入参：
+//   - applyConfig: 域名的申请配置。
+//
+// 出参：
+//   - CA 标识列表。
+//   - 错误。
+func GetCandidateCAs(applyConfig *domain.ApplyConfig) ([]string, error) {
+	sslProvider, err := getSSLProviderConfig(applyConfig.SSLProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return getSSLProviderCandidateCAs(sslProvider, applyConfig)
+}
+
+func getSSLProviderCandidateCAs(sslProvider *SSLProviderConfig, applyConfig *domain.ApplyConfig) ([]string, error) {
+	// 内置 CA 及 CA 测试环境不切换备用 CA
+	if sslProvider.Provider == sslProviderInternal {
+		return []string{getSSLProviderAccountCA(sslProvider)}, nil
+	}
+
+	if applyConfig.Staging {
+		staging, err := getSSLProviderStagingConfig(sslProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{getSSLProviderAccountCA(staging)}, nil
+	}
+
+	candidates := getSSLProviderCandidates(sslProvider, applyConfig.SSLProviderFallbacks)
+	cas := make([]string, 0, len(candidates))
+	for _, provider := range candidates {
+		candidate := *sslProvider
+		candidate.Provider = provider
+		cas = append(cas, getSSLProviderAccountCA(&candidate))
+	}
+
+	return cas, nil
+}
+
+// 获取 CA 测试环境的配置，测试环境使用独立的 CA 标识，因此也使用独立的 ACME 账户。
+// 支持 Let's Encrypt 及配置了测试环境地址的自定义 CA。
+//
+// 入参：
+//   - sslProvider: CA 配置。
+//
+// 出参：
+//   - 测试环境的 CA 配置。
+//   - 错误。
+func getSSLProviderStagingConfig(sslProvider *SSLProviderConfig) (*SSLProviderConfig, error) {
+	staging := *sslProvider
+
+	switch sslProvider.Provider {
+	case sslProviderLetsencrypt, sslProviderLetsencryptStaging:
+		staging.Provider = sslProviderLetsencryptStaging
+	case sslProviderCustom:
+		if sslProvider.Config.Custom.StagingUrl == "" {
+			return nil, errors.New("custom ssl provider staging url is empty")
+		}
+		staging.Config.Custom.Url = sslProvider.Config.Custom.StagingUrl
+	default:
+		return nil, fmt.Errorf("ssl provider %s has no staging environment", sslProvider.Provider)
+	}
+
+	return &staging, nil
+}
+
+// 获取 CA 的 ACME 目录地址。
+func getSSLProviderUrl(sslProvider *SSLProviderConfig) (string, error) {
+	if sslProvider.Provider == sslProviderCustom {
+		if sslProvider.Config.Custom.Url == "" {
+			return "", errors.New("custom ssl provider url is empty")
+		}
+
+		return sslProvider.Config.Custom.Url, nil
+	}
+
+	url, ok := sslProviderUrls[sslProvider.Provider]
+	if !ok {
+		return "", errors.New("unknown ssl provider")
+	}
+
+	return url, nil
+}
+
+// 获取 acme_accounts 中用于区分 CA 的标识。
+// 内置 CA 使用其名称，自定义 CA 使用其 ACME 目录地址，以便不同目录的账户互不干扰。
+func getSSLProviderAccountCA(sslProvider *SSLProviderConfig) string {
+	if sslProvider.Provider == sslProviderCustom {
+		return sslProvider.Config.Custom.Url
+	}
+
+	return sslProvider.Provider
+}
+
+// 为使用自定义根证书的 CA 创建 HTTP 客户端。
+func newSSLProviderHttpClient(caCertificates string, timeout time.Duration) (*http.Client, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM([]byte(caCertificates)) {
+		return nil, errors.New("failed to parse custom ssl provider ca certificates")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
+
+// 获取按顺序尝试的 CA 列表，首个为主 CA，其余为去重后的备用 CA。
+// fallbacks 非空时将覆盖全局配置中的备用 CA 列表。
+func getSSLProviderCandidates(sslProvider *SSLProviderConfig, fallbacks []string) []string {
+	if len(fallbacks) == 0 {
+		fallbacks = sslProvider.Fallbacks
+	}
+
+	candidates := []string{sslProvider.Provider}
+	for _, fallback := range fallbacks {
+		if fallback == "" || slices.Contains(candidates, fallback) {
+			continue
+		}
+
+		candidates = append(candidates, fallback)
+	}
+
+	return candidates
+}
+
+// 判断申请证书失败的错误是否应切换到备用 CA 重试。
+// 仅限流、CA 服务端错误以及与 CA 通信失败或超时时返回 true，
+// 域名验证失败、授权配置错误等与 CA 无关的错误换 CA 也无济于事。
+func isSSLProviderFailoverError(err error) bool {
+	var problem *acme.ProblemDetails
+	if errors.As(err, &problem) {
+		switch {
+		case problem.Type == acmeErrorRateLimited,
+			problem.Type == acmeErrorServerInternal,
+			problem.HTTPStatus == http.StatusTooManyRequests,
+			problem.HTTPStatus >= http.StatusInternalServerError:
+			return true
+		}
+
+		return false
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// ACME 目录，lego 的 acme.Directory 尚不包含证书配置文件。
+type sslProviderDirectory struct {
+	NewOrder string `json:"newOrder"`
+	Meta     struct {
+		CaaIdentities []string `json:"caaIdentities"`
+		// 证书配置文件名称及其说明。
+		Profiles map[string]string `json:"profiles"`
+	} `json:"meta"`
+}
+
+// 读取 CA 的 ACME 目录。
+func getSSLProviderDirectory(client *http.Client, caDirUrl string) (*sslProviderDirectory, error) {
+	res, err := client.Get(caDirUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from acme directory", res.StatusCode)
+	}
+
+	directory := &sslProviderDirectory{}
+	if err := json.NewDecoder(res.Body).Decode(directory); err != nil {
+		return nil, fmt.Errorf("failed to decode acme directory: %w", err)
+	}
+
+	return directory, nil
+}
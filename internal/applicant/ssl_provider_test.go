@@ -0,0 +1,115 @@
+package applicant
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+
+	"certimate/internal/domain"
+)
+
+func TestGetSSLProviderCandidates(t *testing.T) {
+	sslProvider := &SSLProviderConfig{
+		Provider:  sslProviderLetsencrypt,
+		Fallbacks: []string{sslProviderZeroSSL, sslProviderLetsencrypt, "", sslProviderGts},
+	}
+
+	candidates := getSSLProviderCandidates(sslProvider, nil)
+	if !slices.Equal(candidates, []string{sslProviderLetsencrypt, sslProviderZeroSSL, sslProviderGts}) {
+		t.Errorf("unexpected candidates: %v", candidates)
+	}
+
+	candidates = getSSLProviderCandidates(sslProvider, []string{sslProviderGts})
+	if !slices.Equal(candidates, []string{sslProviderLetsencrypt, sslProviderGts}) {
+		t.Errorf("unexpected candidates: %v", candidates)
+	}
+}
+
+func TestIsSSLProviderFailoverError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"RateLimited", &acme.ProblemDetails{Type: acmeErrorRateLimited, HTTPStatus: http.StatusTooManyRequests}, true},
+		{"ServerInternal", &acme.ProblemDetails{Type: acmeErrorServerInternal, HTTPStatus: http.StatusInternalServerError}, true},
+		{"ServiceUnavailable", &acme.ProblemDetails{HTTPStatus: http.StatusServiceUnavailable}, true},
+		{"Unauthorized", &acme.ProblemDetails{Type: "urn:ietf:params:acme:error:unauthorized", HTTPStatus: http.StatusForbidden}, false},
+		{"Wrapped", fmt.Errorf("example.com: %w", &acme.ProblemDetails{Type: acmeErrorRateLimited}), true},
+		{"Network", &url.Error{Op: "Get", URL: letsencryptUrl, Err: errors.New("i/o timeout")}, true},
+		{"Other", errors.New("time limit exceeded"), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isSSLProviderFailoverError(c.err); got != c.want {
+				t.Errorf("isSSLProviderFailoverError() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGetSSLProviderStagingConfig(t *testing.T) {
+	staging, err := getSSLProviderStagingConfig(&SSLProviderConfig{Provider: sslProviderLetsencrypt})
+	if err != nil {
+		t.Fatalf("failed to get staging config: %v", err)
+	}
+	if getSSLProviderAccountCA(staging) != sslProviderLetsencryptStaging {
+		t.Errorf("unexpected staging ca: %s", getSSLProviderAccountCA(staging))
+	}
+	if url, _ := getSSLProviderUrl(staging); url != letsencryptStagingUrl {
+		t.Errorf("unexpected staging url: %s", url)
+	}
+
+	custom := &SSLProviderConfig{Provider: sslProviderCustom}
+	custom.Config.Custom.Url = "https://ca.example.com/acme/directory"
+	custom.Config.Custom.StagingUrl = "https://ca.example.com/acme-staging/directory"
+	staging, err = getSSLProviderStagingConfig(custom)
+	if err != nil {
+		t.Fatalf("failed to get staging config: %v", err)
+	}
+	if getSSLProviderAccountCA(staging) != custom.Config.Custom.StagingUrl {
+		t.Errorf("unexpected staging ca: %s", getSSLProviderAccountCA(staging))
+	}
+	if custom.Config.Custom.Url != "https://ca.example.com/acme/directory" {
+		t.Error("original config should not be modified")
+	}
+
+	custom.Config.Custom.StagingUrl = ""
+	if _, err := getSSLProviderStagingConfig(custom); err == nil {
+		t.Error("expected error for custom ca without staging url")
+	}
+
+	if _, err := getSSLProviderStagingConfig(&SSLProviderConfig{Provider: sslProviderZeroSSL}); err == nil {
+		t.Error("expected error for ca without staging environment")
+	}
+}
+
+func TestGetSSLProviderCandidateCAs(t *testing.T) {
+	sslProvider := &SSLProviderConfig{Provider: sslProviderCustom}
+	sslProvider.Config.Custom.Url = "https://ca.example.com/acme/directory"
+
+	applyConfig := &domain.ApplyConfig{SSLProviderFallbacks: []string{sslProviderLetsencrypt}}
+	cas, err := getSSLProviderCandidateCAs(sslProvider, applyConfig)
+	if err != nil {
+		t.Fatalf("failed to get candidate cas: %v", err)
+	}
+	// 由备用 CA 签发的证书记录的是备用 CA 的标识
+	if !slices.Equal(cas, []string{"https://ca.example.com/acme/directory", sslProviderLetsencrypt}) {
+		t.Errorf("unexpected candidate cas: %v", cas)
+	}
+
+	applyConfig.Staging = true
+	cas, err = getSSLProviderCandidateCAs(&SSLProviderConfig{Provider: sslProviderLetsencrypt}, applyConfig)
+	if err != nil {
+		t.Fatalf("failed to get candidate cas: %v", err)
+	}
+	if !slices.Equal(cas, []string{sslProviderLetsencryptStaging}) {
+		t.Errorf("unexpected staging candidate cas: %v", cas)
+	}
+}
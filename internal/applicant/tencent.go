@@ -2,9 +2,8 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/providers/dns/tencentcloud"
 
 	"certimate/internal/domain"
@@ -21,17 +20,22 @@ func NewTencent(option *ApplyOption) Applicant {
 }
 
 func (t *tencent) Apply() (*Certificate, error) {
-	access := &domain.TencentAccess{}
-	json.Unmarshal([]byte(t.option.Access), access)
-
-	os.Setenv("TENCENTCLOUD_SECRET_ID", access.SecretId)
-	os.Setenv("TENCENTCLOUD_SECRET_KEY", access.SecretKey)
-	os.Setenv("TENCENTCLOUD_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", t.option.Timeout))
-
-	dnsProvider, err := tencentcloud.NewDNSProvider()
+	dnsProvider, err := t.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(t.option, dnsProvider)
 }
+
+func (t *tencent) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.TencentAccess{}
+	json.Unmarshal([]byte(t.option.Access), access)
+
+	config := newDefaultConfig(tencentcloud.NewDefaultConfig)
+	config.SecretID = access.SecretId
+	config.SecretKey = access.SecretKey
+	config.PropagationTimeout = getPropagationTimeout(t.option)
+
+	return tencentcloud.NewDNSProviderConfig(config)
+}
@@ -0,0 +1,154 @@
+package applicant
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
+	"github.com/go-acme/lego/v4/lego"
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"certimate/internal/domain"
+	sshutil "certimate/internal/pkg/utils/ssh"
+	"certimate/internal/utils/app"
+)
+
+const (
+	defaultTlsAlpnChallengePort = "443"
+	// 单个连接完成 TLS 握手的最长时间，避免未完成握手的连接一直占用
+	tlsAlpnHandshakeTimeout = 10 * time.Second
+)
+
+type tlsAlpnChallenge struct {
+	option *ApplyOption
+}
+
+func NewTlsAlpnChallenge(option *ApplyOption) Applicant {
+	return &tlsAlpnChallenge{
+		option: option,
+	}
+}
+
+func (t *tlsAlpnChallenge) Apply() (*Certificate, error) {
+	config := t.option.TlsAlpnChallenge
+	if config == nil {
+		config = &domain.TlsAlpnChallengeConfig{}
+	}
+
+	port := config.Port
+	if port == "" {
+		port = defaultTlsAlpnChallengePort
+	}
+
+	var provider challenge.Provider
+	if config.Access == "" {
+		provider = tlsalpn01.NewProviderServer(config.Interface, port)
+	} else {
+		access, err := app.GetApp().Dao().FindRecordById("access", config.Access)
+		if err != nil {
+			return nil, fmt.Errorf("access record not found: %w", err)
+		}
+
+		if access.GetString("configType") != configTypeSSH {
+			return nil, fmt.Errorf("unsupported tls-alpn challenge access type: %s", access.GetString("configType"))
+		}
+
+		sshAccess := &domain.SSHAccess{}
+		if err := json.Unmarshal([]byte(access.GetString("config")), sshAccess); err != nil {
+			return nil, err
+		}
+
+		provider = &tlsAlpnChallengeSshProvider{
+			access:  sshAccess,
+			address: net.JoinHostPort(config.Interface, port),
+		}
+	}
+
+	return applyWithChallenge(t.option, func(client *lego.Client) error {
+		return client.Challenge.SetTLSALPN01Provider(provider)
+	})
+}
+
+// 通过 SSH 远程端口转发在远程主机上监听，并在本地完成 acme-tls/1 握手。
+// 远程主机的 sshd 需要允许端口转发，监听非回环地址时还需要开启 GatewayPorts。
+type tlsAlpnChallengeSshProvider struct {
+	access   *domain.SSHAccess
+	address  string
+	client   *ssh.Client
+	listener net.Listener
+}
+
+func (p *tlsAlpnChallengeSshProvider) Present(domain, token, keyAuth string) error {
+	cert, err := tlsalpn01.ChallengeCert(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	port, _ := strconv.ParseInt(p.access.Port, 10, 32)
+	client, err := sshutil.NewClient(p.access.Host, int32(port), p.access.Username, p.access.Password, p.access.Key, p.access.KeyPassphrase)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create ssh client")
+	}
+
+	listener, err := client.Listen("tcp", p.address)
+	if err != nil {
+		client.Close()
+		return xerrors.Wrapf(err, "failed to listen on remote address %s", p.address)
+	}
+
+	// 接受连接的协程只使用本次创建的监听，CleanUp 及之后的 Present 会修改 p.listener
+	tlsListener := tls.NewListener(listener, &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		NextProtos:   []string{tlsalpn01.ACMETLS1Protocol},
+	})
+	p.client = client
+	p.listener = tlsListener
+
+	go func() {
+		for {
+			conn, err := tlsListener.Accept()
+			if err != nil {
+				// 监听关闭后退出，SSH 转发的监听关闭时返回 io.EOF；其他错误仅影响单个连接
+				if errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
+					return
+				}
+				continue
+			}
+
+			go func() {
+				defer conn.Close()
+
+				// SSH 转发的连接不支持 SetDeadline，超时后由 HandshakeContext 关闭连接
+				ctx, cancel := context.WithTimeout(context.Background(), tlsAlpnHandshakeTimeout)
+				defer cancel()
+
+				conn.(*tls.Conn).HandshakeContext(ctx)
+			}()
+		}
+	}()
+
+	return nil
+}
+
+func (p *tlsAlpnChallengeSshProvider) CleanUp(domain, token, keyAuth string) error {
+	if p.listener != nil {
+		p.listener.Close()
+		p.listener = nil
+	}
+
+	if p.client != nil {
+		p.client.Close()
+		p.client = nil
+	}
+
+	return nil
+}
@@ -2,11 +2,10 @@ package applicant
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
 
 	"certimate/internal/domain"
 
+	"github.com/go-acme/lego/v4/challenge"
 	volcengineDns "github.com/go-acme/lego/v4/providers/dns/volcengine"
 )
 
@@ -21,16 +20,22 @@ func NewVolcengine(option *ApplyOption) Applicant {
 }
 
 func (a *volcengine) Apply() (*Certificate, error) {
-	access := &domain.VolcEngineAccess{}
-	json.Unmarshal([]byte(a.option.Access), access)
-
-	os.Setenv("VOLC_ACCESSKEY", access.AccessKeyId)
-	os.Setenv("VOLC_SECRETKEY", access.SecretAccessKey)
-	os.Setenv("VOLC_PROPAGATION_TIMEOUT", fmt.Sprintf("%d", a.option.Timeout))
-	dnsProvider, err := volcengineDns.NewDNSProvider()
+	dnsProvider, err := a.newDNSProvider()
 	if err != nil {
 		return nil, err
 	}
 
 	return apply(a.option, dnsProvider)
 }
+
+func (a *volcengine) newDNSProvider() (challenge.Provider, error) {
+	access := &domain.VolcEngineAccess{}
+	json.Unmarshal([]byte(a.option.Access), access)
+
+	config := newDefaultConfig(volcengineDns.NewDefaultConfig)
+	config.AccessKey = access.AccessKeyId
+	config.SecretKey = access.SecretAccessKey
+	config.PropagationTimeout = getPropagationTimeout(a.option)
+
+	return volcengineDns.NewDNSProviderConfig(config)
+}
@@ -0,0 +1,304 @@
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/pocketbase/pocketbase/tools/security"
+
+	"certimate/internal/domain"
+	xx509 "certimate/internal/pkg/utils/x509"
+	"certimate/internal/repository"
+)
+
+const (
+	rootCommonName         = "Certimate Internal Root CA"
+	intermediateCommonName = "Certimate Internal Intermediate CA"
+
+	rootValidity         = time.Hour * 24 * 365 * 20
+	intermediateValidity = time.Hour * 24 * 365 * 10
+
+	defaultLeafValidityDays = 365
+)
+
+type InternalCARepository interface {
+	GetByKind(kind string) (*domain.InternalCA, error)
+	Save(kind, certificate, privateKey string) error
+}
+
+func getInternalCARepository() InternalCARepository {
+	return repository.NewInternalCARepository()
+}
+
+// 内置 CA，由根证书及其签发的中间证书组成，叶子证书由中间证书签发。
+type Authority struct {
+	RootCertificate         string
+	IntermediateCertificate string
+
+	intermediate    *x509.Certificate
+	intermediateKey crypto.Signer
+}
+
+var (
+	authority   *Authority
+	authorityMu sync.Mutex
+)
+
+// 获取内置 CA。首次使用时生成根证书及中间证书，并将私钥加密后保存到数据库中。
+//
+// 出参：
+//   - 内置 CA。
+//   - 错误。
+func Get() (*Authority, error) {
+	authorityMu.Lock()
+	defer authorityMu.Unlock()
+
+	if authority != nil {
+		return authority, nil
+	}
+
+	repo := getInternalCARepository()
+
+	root, err := repo.GetByKind(domain.InternalCAKindRoot)
+	if errors.Is(err, sql.ErrNoRows) {
+		root, err = createRoot(repo)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root ca: %w", err)
+	}
+
+	intermediate, err := repo.GetByKind(domain.InternalCAKindIntermediate)
+	if errors.Is(err, sql.ErrNoRows) {
+		intermediate, err = createIntermediate(repo, root)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get intermediate ca: %w", err)
+	}
+
+	intermediateCert, err := xx509.ParseCertificateFromPEM(intermediate.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediateKey, err := decryptPrivateKey(intermediate.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	authority = &Authority{
+		RootCertificate:         root.Certificate,
+		IntermediateCertificate: intermediate.Certificate,
+		intermediate:            intermediateCert,
+		intermediateKey:         intermediateKey,
+	}
+
+	return authority, nil
+}
+
+// 叶子证书的签发请求。
+type IssueRequest struct {
+	Subject pkix.Name
+	// 域名及 IP 地址。
+	SANs         []string
+	ValidityDays int
+	PublicKey    crypto.PublicKey
+}
+
+// 使用中间证书签发叶子证书。
+//
+// 入参：
+//   - req: 签发请求。
+//
+// 出参：
+//   - 叶子证书 PEM 内容。
+//   - 错误。
+func (a *Authority) Issue(req *IssueRequest) (string, error) {
+	if len(req.SANs) == 0 {
+		return "", errors.New("sans is empty")
+	}
+
+	validityDays := req.ValidityDays
+	if validityDays <= 0 {
+		validityDays = defaultLeafValidityDays
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               req.Subject,
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour * 24 * time.Duration(validityDays)),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, san := range req.SANs {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+
+	// 叶子证书不能超过中间证书的有效期
+	if template.NotAfter.After(a.intermediate.NotAfter) {
+		template.NotAfter = a.intermediate.NotAfter
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.intermediate, req.PublicKey, a.intermediateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeCertificate(der), nil
+}
+
+func createRoot(repo InternalCARepository) (*domain.InternalCA, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: rootCommonName, Organization: []string{"Certimate"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(rootValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            1,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return saveAuthority(repo, domain.InternalCAKindRoot, der, key)
+}
+
+func createIntermediate(repo InternalCARepository, root *domain.InternalCA) (*domain.InternalCA, error) {
+	rootCert, err := xx509.ParseCertificateFromPEM(root.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	rootKey, err := decryptPrivateKey(root.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: intermediateCommonName, Organization: []string{"Certimate"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(intermediateValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	if template.NotAfter.After(rootCert.NotAfter) {
+		template.NotAfter = rootCert.NotAfter
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, rootCert, &key.PublicKey, rootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return saveAuthority(repo, domain.InternalCAKindIntermediate, der, key)
+}
+
+func saveAuthority(repo InternalCARepository, kind string, der []byte, key crypto.Signer) (*domain.InternalCA, error) {
+	certPem := encodeCertificate(der)
+
+	encryptedKey, err := encryptPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.Save(kind, certPem, encryptedKey); err != nil {
+		return nil, err
+	}
+
+	return &domain.InternalCA{
+		Kind:        kind,
+		Certificate: certPem,
+		PrivateKey:  encryptedKey,
+	}, nil
+}
+
+func encryptPrivateKey(key crypto.Signer) (string, error) {
+	encryptionKey, err := getEncryptionKey()
+	if err != nil {
+		return "", err
+	}
+
+	return security.Encrypt(certcrypto.PEMEncode(key), encryptionKey)
+}
+
+func decryptPrivateKey(encryptedKey string) (crypto.Signer, error) {
+	encryptionKey, err := getEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	keyPem, err := security.Decrypt(encryptedKey, encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt ca private key: %w", err)
+	}
+
+	key, err := certcrypto.ParsePEMPrivateKey(keyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("ca private key is not a signer")
+	}
+
+	return signer, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,80 @@
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	xx509 "certimate/internal/pkg/utils/x509"
+)
+
+func TestIssue(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: intermediateCommonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour * 24 * 30),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authority := &Authority{
+		IntermediateCertificate: encodeCertificate(der),
+		intermediate:            intermediate,
+		intermediateKey:         key,
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafPem, err := authority.Issue(&IssueRequest{
+		Subject:      pkix.Name{CommonName: "internal.example.com"},
+		SANs:         []string{"internal.example.com", "10.0.0.1"},
+		ValidityDays: 365,
+		PublicKey:    &leafKey.PublicKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf, err := xx509.ParseCertificateFromPEM(leafPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != "internal.example.com" {
+		t.Errorf("unexpected dns names: %v", leaf.DNSNames)
+	}
+	if len(leaf.IPAddresses) != 1 || !leaf.IPAddresses[0].Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("unexpected ip addresses: %v", leaf.IPAddresses)
+	}
+	if leaf.NotAfter.After(intermediate.NotAfter) {
+		t.Errorf("leaf certificate outlives intermediate certificate")
+	}
+
+	if err := leaf.CheckSignatureFrom(intermediate); err != nil {
+		t.Errorf("unexpected signature: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package ca
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pocketbase/pocketbase/tools/security"
+
+	"certimate/internal/utils/app"
+)
+
+const encryptionKeyFileName = ".internal_ca_key"
+
+// 获取加密 CA 私钥使用的 32 位密钥。
+// 优先使用 PocketBase 的 --encryptionEnv 参数指定的环境变量，未指定时使用数据目录下自动生成的密钥文件。
+// 密钥不保存在数据库中，单独泄露数据库文件不会泄露 CA 私钥。
+func getEncryptionKey() (string, error) {
+	if env := app.GetApp().EncryptionEnv(); env != "" {
+		if key := os.Getenv(env); key != "" {
+			if len(key) != 32 {
+				return "", errors.New("encryption key must be 32 characters")
+			}
+
+			return key, nil
+		}
+	}
+
+	path := filepath.Join(app.GetApp().DataDir(), encryptionKeyFileName)
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return strings.TrimSpace(string(data)), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	key := security.RandomString(32)
+	if err := os.WriteFile(path, []byte(key), 0o600); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
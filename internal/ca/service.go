@@ -0,0 +1,28 @@
+package ca
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"certimate/internal/domain"
+)
+
+type InternalCAService struct{}
+
+func NewInternalCAService() *InternalCAService {
+	return &InternalCAService{}
+}
+
+// 获取内置 CA 的根证书。下载接口无需认证，因此只读取已有的根证书，不在此处生成。
+func (s *InternalCAService) GetRootCertificate(ctx context.Context) (string, error) {
+	root, err := getInternalCARepository().GetByKind(domain.InternalCAKindRoot)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrInternalCANotFound
+		}
+		return "", err
+	}
+
+	return root.Certificate, nil
+}
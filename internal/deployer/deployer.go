@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/pocketbase/pocketbase/models"
+	"golang.org/x/exp/slices"
 
 	"certimate/internal/applicant"
 	"certimate/internal/domain"
@@ -40,6 +41,11 @@ const (
 	targetK8sSecret      = "k8s-secret"
 )
 
+// 不需要私钥即可部署的目标
+var targetsWithoutPrivateKey = []string{
+	targetWebhook,
+}
+
 type DeployerOption struct {
 	DomainId     string                `json:"domainId"`
 	Domain       string                `json:"domain"`
@@ -104,9 +110,20 @@ func getWithDeployConfig(record *models.Record, cert *applicant.Certificate, dep
 		option.Certificate = applicant.Certificate{
 			Certificate: record.GetString("certificate"),
 			PrivateKey:  record.GetString("privateKey"),
+			Untrusted:   record.GetBool("untrusted"),
 		}
 	}
 
+	// CA 测试环境签发的证书不受信任，只能部署到标记为测试的目标
+	if option.Certificate.Untrusted && !deployConfig.Test {
+		return nil, fmt.Errorf("域名 %s 的证书由 CA 测试环境签发，不受信任，部署目标 %s 未标记为测试目标", option.Domain, deployConfig.Type)
+	}
+
+	// 使用上传的 CSR 签发的证书没有私钥，只能部署到不需要私钥的目标
+	if option.Certificate.PrivateKey == "" && !slices.Contains(targetsWithoutPrivateKey, deployConfig.Type) {
+		return nil, fmt.Errorf("部署目标 %s 需要私钥，但域名 %s 的证书没有私钥（可能是通过上传的 CSR 签发的）", deployConfig.Type, option.Domain)
+	}
+
 	switch deployConfig.Type {
 	case targetAliyunOSS:
 		return NewAliyunOSSDeployer(option)
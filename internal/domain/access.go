@@ -102,3 +102,44 @@ type UnicloudAccess struct {
 type KubernetesAccess struct {
 	KubeConfig string `json:"kubeConfig"`
 }
+
+// 通过 lego 支持的任意 DNS 提供商申请证书。
+type LegoAccess struct {
+	// lego 的 DNS 提供商代码，如 "gandiv5"、"porkbun"。
+	Provider string `json:"provider"`
+	// 提供商选项，键为 lego 文档中的环境变量名，如 "GANDIV5_PERSONAL_ACCESS_TOKEN"。
+	Options map[string]string `json:"options"`
+}
+
+type Rfc2136Access struct {
+	// 权威 DNS 服务器地址，未指定端口时使用 53。
+	Nameserver string `json:"nameserver"`
+	// TSIG 密钥名称、算法（如 "hmac-sha256"）及 Base64 编码的密钥，名称为空时不签名。
+	TsigKey       string `json:"tsigKey"`
+	TsigAlgorithm string `json:"tsigAlgorithm"`
+	TsigSecret    string `json:"tsigSecret"`
+}
+
+type AcmeDnsAccess struct {
+	// acme-dns 服务地址，如 "https://auth.acme-dns.io"。
+	ApiBase string `json:"apiBase"`
+	// 注册账户时限制可更新记录的来源网段，为空时不限制。
+	AllowFrom []string `json:"allowFrom"`
+	// 域名未注册时是否自动注册，注册后需手动添加日志中提示的 CNAME 记录再重新申请。
+	AutoRegister bool `json:"autoRegister"`
+	// 已注册的账户，键为域名（不含通配符前缀）。
+	Registrations map[string]AcmeDnsRegistration `json:"registrations"`
+}
+
+type AcmeDnsRegistration struct {
+	FullDomain string `json:"fulldomain"`
+	SubDomain  string `json:"subdomain"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	ServerUrl  string `json:"server_url"`
+}
+
+type ManualDnsAccess struct {
+	// 等待管理员添加 TXT 记录并确认的时间，单位为分钟，为 0 时使用默认值。
+	ConfirmTimeout int64 `json:"confirmTimeout"`
+}
@@ -6,12 +6,42 @@ import (
 	"github.com/go-acme/lego/v4/registration"
 )
 
+const (
+	AcmeAccountStatusValid       = "valid"
+	AcmeAccountStatusDeactivated = "deactivated"
+)
+
 type AcmeAccount struct {
 	Id       string
 	Ca       string
 	Email    string
+	Status   string
 	Resource *registration.Resource
 	Key      string
 	Created  time.Time
 	Updated  time.Time
 }
+
+// 账户列表中展示的信息，不包含账户私钥。
+type AcmeAccountResp struct {
+	Id      string    `json:"id"`
+	Ca      string    `json:"ca"`
+	Email   string    `json:"email"`
+	Status  string    `json:"status"`
+	Uri     string    `json:"uri"`
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+type AcmeAccountKeyChangeReq struct {
+	Id string `json:"-"`
+}
+
+type AcmeAccountUpdateContactReq struct {
+	Id    string `json:"-"`
+	Email string `json:"email"`
+}
+
+type AcmeAccountDeactivateReq struct {
+	Id string `json:"-"`
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	AcmeServerStatusPending     = "pending"
+	AcmeServerStatusReady       = "ready"
+	AcmeServerStatusProcessing  = "processing"
+	AcmeServerStatusValid       = "valid"
+	AcmeServerStatusInvalid     = "invalid"
+	AcmeServerStatusDeactivated = "deactivated"
+)
+
+// 内置 ACME 服务的设置，保存在 settings 的 "acme-server" 记录中。
+type AcmeServerSetting struct {
+	Enabled bool `json:"enabled"`
+	// 允许申请的域名，支持 "*.example.com" 形式匹配任意层级的子域名。
+	AllowedDomains []string `json:"allowedDomains"`
+	// 作为模板的域名记录 ID，使用其申请配置中的授权及 CA 签发证书。
+	DomainId string `json:"domainId"`
+	// 外部账户绑定（EAB）凭据，注册账户必须提供。
+	// ACME 服务无需管理员认证即可访问，未配置 EAB 时不会启用。
+	EabKid     string `json:"eabKid"`
+	EabHmacKey string `json:"eabHmacKey"`
+}
+
+func (s *AcmeServerSetting) HasExternalAccountBinding() bool {
+	return s.EabKid != "" && s.EabHmacKey != ""
+}
+
+type AcmeServerAccount struct {
+	Id         string
+	Status     string
+	Key        string
+	Thumbprint string
+	Contact    []string
+	Created    time.Time
+}
+
+type AcmeServerIdentifier struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type AcmeServerOrder struct {
+	Id          string
+	Account     string
+	Status      string
+	Identifiers []AcmeServerIdentifier
+	Csr         string
+	Certificate string
+	Error       *AcmeServerProblem
+	Expires     time.Time
+	Created     time.Time
+}
+
+// 经过 JWS 校验的 ACME 请求。
+type AcmeServerRequest struct {
+	Url     string
+	Payload []byte
+	// 使用 kid 签名时为对应的账户。
+	Account *AcmeServerAccount
+	// 使用 jwk 签名时为公钥及其指纹。
+	Jwk        string
+	Thumbprint string
+}
+
+type AcmeServerNewAccountReq struct {
+	Contact                []string `json:"contact"`
+	TermsOfServiceAgreed   bool     `json:"termsOfServiceAgreed"`
+	OnlyReturnExisting     bool     `json:"onlyReturnExisting"`
+	ExternalAccountBinding any      `json:"externalAccountBinding"`
+}
+
+type AcmeServerUpdateAccountReq struct {
+	Contact []string `json:"contact"`
+	Status  string   `json:"status"`
+}
+
+type AcmeServerNewOrderReq struct {
+	Identifiers []AcmeServerIdentifier `json:"identifiers"`
+}
+
+type AcmeServerFinalizeReq struct {
+	Csr string `json:"csr"`
+}
+
+// RFC 8555 6.7 定义的错误。
+type AcmeServerProblem struct {
+	Type   string `json:"type"`
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+}
+
+func NewAcmeServerProblem(status int, typ string, format string, args ...any) *AcmeServerProblem {
+	return &AcmeServerProblem{
+		Type:   "urn:ietf:params:acme:error:" + typ,
+		Detail: fmt.Sprintf(format, args...),
+		Status: status,
+	}
+}
+
+func (p *AcmeServerProblem) Error() string {
+	return p.Detail
+}
+
+func ToAcmeServerProblem(err error) *AcmeServerProblem {
+	if p, ok := err.(*AcmeServerProblem); ok {
+		return p
+	}
+
+	return NewAcmeServerProblem(http.StatusInternalServerError, "serverInternal", "%s", err.Error())
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	stdx509 "crypto/x509"
+	"time"
+
+	"certimate/internal/pkg/utils/x509"
+)
+
+const (
+	CertificateFormatPEM = "pem"
+	CertificateFormatPFX = "pfx"
+	CertificateFormatJKS = "jks"
+)
+
+type CertificateMeta struct {
+	IssuedAt     time.Time `json:"issuedAt"`
+	ExpiredAt    time.Time `json:"expiredAt"`
+	SerialNumber string    `json:"serialNumber"`
+	Issuer       string    `json:"issuer"`
+	SANs         []string  `json:"sans"`
+	KeyAlgorithm string    `json:"keyAlgorithm"`
+	Fingerprint  string    `json:"fingerprint"`
+	ChainRoot    string    `json:"chainRoot"`
+}
+
+// 从 PEM 编码的证书字符串中解析证书元数据。
+// 证书链中的第一张证书为终端实体证书，链的根证书通用名称取自链中最后一张证书。
+//
+// 入参：
+//   - certPem: 证书 PEM 内容。
+//
+// 出参：
+//   - 证书元数据。
+//   - 错误。
+func ParseCertificateMeta(certPem string) (*CertificateMeta, error) {
+	certs, err := x509.ParseCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := certs[0]
+
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return &CertificateMeta{
+		IssuedAt:     cert.NotBefore,
+		ExpiredAt:    cert.NotAfter,
+		SerialNumber: cert.SerialNumber.Text(16),
+		Issuer:       cert.Issuer.String(),
+		SANs:         sans,
+		KeyAlgorithm: x509.GetPublicKeyAlgorithm(cert),
+		Fingerprint:  x509.GetFingerprintSHA256(cert),
+		ChainRoot:    getChainRoot(certs[len(certs)-1]),
+	}, nil
+}
+
+// 按证书有效期缩放到期前的时间窗口，窗口不超过有效期的一半，
+// 避免有效期较短的证书（如 6 天的短期证书）签发后即落入窗口。续期及到期提醒使用相同的规则。
+//
+// 入参：
+//   - window: 有效期较长的证书使用的时间窗口。
+//   - lifetime: 证书有效期。
+//
+// 出参：
+//   - 时间窗口。
+func ScaleCertificateWindow(window, lifetime time.Duration) time.Duration {
+	return min(window, lifetime/2)
+}
+
+// 证书链中通常不包含根证书，因此链中最后一张证书若不是自签名的，其颁发者即为根证书。
+func getChainRoot(cert *stdx509.Certificate) string {
+	if cert.Subject.String() == cert.Issuer.String() {
+		return cert.Subject.CommonName
+	}
+
+	return cert.Issuer.CommonName
+}
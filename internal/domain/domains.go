@@ -7,13 +7,137 @@ import (
 	"certimate/internal/pkg/utils/maps"
 )
 
+const (
+	ChallengeTypeDNS01     = "dns-01"
+	ChallengeTypeHTTP01    = "http-01"
+	ChallengeTypeTLSALPN01 = "tls-alpn-01"
+)
+
+const (
+	KeyModeGenerate = "generate"
+	KeyModeReuse    = "reuse"
+	KeyModeCSR      = "csr"
+)
+
+const (
+	HttpChallengeSolverStandalone = "standalone"
+	HttpChallengeSolverRouter     = "router"
+	HttpChallengeSolverWebroot    = "webroot"
+)
+
 type ApplyConfig struct {
-	Email              string `json:"email"`
-	Access             string `json:"access"`
-	KeyAlgorithm       string `json:"keyAlgorithm"`
-	Nameservers        string `json:"nameservers"`
-	Timeout            int64  `json:"timeout"`
-	DisableFollowCNAME bool   `json:"disableFollowCNAME"`
+	Email                string                  `json:"email"`
+	Access               string                  `json:"access"`
+	KeyAlgorithm         string                  `json:"keyAlgorithm"`
+	Nameservers          string                  `json:"nameservers"`
+	Timeout              int64                   `json:"timeout"`
+	DisableFollowCNAME   bool                    `json:"disableFollowCNAME"`
+	SSLProvider          string                  `json:"sslProvider"`
+	SSLProviderFallbacks []string                `json:"sslProviderFallbacks"`
+	RenewalPercentage    int                     `json:"renewalPercentage"`
+	PreferredChain       string                  `json:"preferredChain"`
+	KeyMode              string                  `json:"keyMode"`
+	Csr                  string                  `json:"csr"`
+	ChallengeType        string                  `json:"challengeType"`
+	HttpChallenge        *HttpChallengeConfig    `json:"httpChallenge,omitempty"`
+	TlsAlpnChallenge     *TlsAlpnChallengeConfig `json:"tlsAlpnChallenge,omitempty"`
+	InternalCA           *InternalCAConfig       `json:"internalCA,omitempty"`
+	DnsAliases           []DnsAliasConfig        `json:"dnsAliases,omitempty"`
+	DnsProviders         []DnsProviderConfig     `json:"dnsProviders,omitempty"`
+	// 是否使用 CA 的测试环境，签发的证书不受信任，仅能部署到标记为测试的目标。
+	Staging bool `json:"staging"`
+	// ACME 证书配置文件，如 Let's Encrypt 的 "shortlived"（6 天有效期）、"tlsserver"。
+	// 零值时使用 CA 的默认配置。
+	Profile string `json:"profile"`
+}
+
+type HttpChallengeConfig struct {
+	// 求解器类型，可选值为 "standalone"、"router"、"webroot"。
+	// 零值时默认为 "standalone"。
+	Solver string `json:"solver"`
+	// standalone 模式下监听的网卡地址。
+	Interface string `json:"interface"`
+	// standalone 模式下监听的端口。
+	// 零值时默认为 "80"。
+	Port string `json:"port"`
+	// webroot 模式下使用的授权记录 ID。
+	// 支持 SSH、阿里云（OSS）、腾讯云（COS）类型的授权。
+	Access string `json:"access"`
+	// webroot 模式下的网站根目录（SSH）或存储桶内的路径前缀（OSS/COS）。
+	Webroot string `json:"webroot"`
+	// webroot 模式下对象存储的地域。
+	Region string `json:"region"`
+	// webroot 模式下对象存储的存储桶名。
+	Bucket string `json:"bucket"`
+}
+
+type TlsAlpnChallengeConfig struct {
+	// 监听的网卡地址。
+	Interface string `json:"interface"`
+	// 监听的端口。
+	// 零值时默认为 "443"。
+	Port string `json:"port"`
+	// 远程主机的 SSH 授权记录 ID。
+	// 非空时将通过 SSH 远程端口转发在该主机上监听，而不是在 Certimate 所在主机上。
+	Access string `json:"access"`
+}
+
+// DNS 别名模式，`_acme-challenge.<Domain>` 通过 CNAME 指向其他区域中的记录，
+// TXT 记录使用该区域的授权写入。
+type DnsAliasConfig struct {
+	// 使用别名验证的域名，通配符域名与其主域名共用同一条记录。
+	Domain string `json:"domain"`
+	// CNAME 指向的记录，如 "example-com.acme.example.net"。
+	AliasDomain string `json:"aliasDomain"`
+	// 别名记录所在区域的授权记录 ID。
+	Access string `json:"access"`
+}
+
+// 按区域或域名模式指定 DNS-01 验证使用的授权，未匹配的域名使用 ApplyConfig.Access。
+type DnsProviderConfig struct {
+	// 区域（如 "example.com"，匹配其自身及所有子域名）或通配模式（如 "*.example.com"，仅匹配子域名）。
+	Pattern string `json:"pattern"`
+	// DNS 服务商授权记录 ID。
+	Access string `json:"access"`
+}
+
+// 使用内置 CA 签发证书时的证书主题及有效期。
+type InternalCAConfig struct {
+	// 证书主题的通用名称。
+	// 零值时默认为第一个域名。
+	CommonName         string `json:"commonName"`
+	Organization       string `json:"organization"`
+	OrganizationalUnit string `json:"organizationalUnit"`
+	Country            string `json:"country"`
+	Province           string `json:"province"`
+	Locality           string `json:"locality"`
+	// 证书有效期天数。
+	// 零值时默认为 365。
+	ValidityDays int `json:"validityDays"`
+}
+
+// 获取私钥模式。
+//
+// 出参：
+//   - 私钥模式。未设置时默认为每次生成新的私钥。
+func (ac *ApplyConfig) GetKeyMode() string {
+	if ac.KeyMode == "" {
+		return KeyModeGenerate
+	}
+
+	return ac.KeyMode
+}
+
+// 获取证书申请时使用的验证方式。
+//
+// 出参：
+//   - 验证方式。未设置时默认为 DNS-01。
+func (ac *ApplyConfig) GetChallengeType() string {
+	if ac.ChallengeType == "" {
+		return ChallengeTypeDNS01
+	}
+
+	return ac.ChallengeType
 }
 
 type DeployConfig struct {
@@ -21,6 +145,8 @@ type DeployConfig struct {
 	Access string         `json:"access"`
 	Type   string         `json:"type"`
 	Config map[string]any `json:"config"`
+	// 是否为测试目标，仅测试目标可以部署测试环境签发的不受信任证书。
+	Test bool `json:"test"`
 }
 
 // 以字符串形式获取配置项。
@@ -142,3 +268,27 @@ type KV struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
+
+type DomainRevokeReq struct {
+	DomainId     string `json:"-"`
+	DeploymentId string `json:"deploymentId"`
+	Reason       uint   `json:"reason"`
+	Reissue      bool   `json:"reissue"`
+}
+
+// 导入外部证书的请求，PEM 格式使用 Certificate 和 PrivateKey，PFX 或 JKS 格式使用 Base64 编码的 Data。
+type DomainImportReq struct {
+	DomainId    string `json:"domainId"`
+	Format      string `json:"format"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+	Data        string `json:"data"`
+	Password    string `json:"password"`
+	JksAlias    string `json:"jksAlias"`
+	JksKeypass  string `json:"jksKeypass"`
+	Deploy      bool   `json:"deploy"`
+}
+
+type DomainImportResp struct {
+	DomainId string `json:"domainId"`
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	InternalCAKindRoot         = "root"
+	InternalCAKindIntermediate = "intermediate"
+)
+
+// 内置 CA 尚未生成，首次使用内置 CA 签发证书时生成。
+var ErrInternalCANotFound = errors.New("internal ca has not been created yet")
+
+// 内置 CA 的根证书或中间证书。PrivateKey 为加密后的私钥。
+type InternalCA struct {
+	Id          string
+	Kind        string
+	Certificate string
+	PrivateKey  string
+	Created     time.Time
+	Updated     time.Time
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+const (
+	ManualDnsChallengeStatusPending   = "pending"
+	ManualDnsChallengeStatusConfirmed = "confirmed"
+	ManualDnsChallengeStatusExpired   = "expired"
+)
+
+// 手动 DNS 验证中等待管理员添加的 TXT 记录。
+type ManualDnsChallenge struct {
+	Id         string    `json:"id"`
+	DomainId   string    `json:"domainId"`
+	Identifier string    `json:"identifier"`
+	Fqdn       string    `json:"fqdn"`
+	Value      string    `json:"value"`
+	Status     string    `json:"status"`
+	ExpiredAt  time.Time `json:"expiredAt"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+}
+
+type ManualDnsChallengeListReq struct {
+	DomainId string `json:"-"`
+}
+
+type ManualDnsChallengeConfirmReq struct {
+	DomainId string `json:"-"`
+}
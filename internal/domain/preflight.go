@@ -0,0 +1,41 @@
+package domain
+
+const (
+	PreflightCheckResolve = "resolve"
+	PreflightCheckCAA     = "caa"
+	PreflightCheckCNAME   = "cname"
+	PreflightCheckTXT     = "txt"
+)
+
+const (
+	PreflightStatusPass = "pass"
+	PreflightStatusWarn = "warn"
+	PreflightStatusFail = "fail"
+	PreflightStatusSkip = "skip"
+)
+
+type DomainPreflightReq struct {
+	DomainId string `json:"-"`
+}
+
+// 申请证书前的预检报告，任一检查项失败时 Passed 为 false。
+type DomainPreflightReport struct {
+	Domain        string                 `json:"domain"`
+	ChallengeType string                 `json:"challengeType"`
+	SSLProvider   string                 `json:"sslProvider"`
+	Nameservers   []string               `json:"nameservers"`
+	Passed        bool                   `json:"passed"`
+	Names         []*PreflightNameReport `json:"names"`
+}
+
+type PreflightNameReport struct {
+	Name   string            `json:"name"`
+	Checks []*PreflightCheck `json:"checks"`
+}
+
+type PreflightCheck struct {
+	Type    string   `json:"type"`
+	Status  string   `json:"status"`
+	Message string   `json:"message"`
+	Records []string `json:"records,omitempty"`
+}
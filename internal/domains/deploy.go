@@ -2,12 +2,16 @@ package domains
 
 import (
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/rsa"
+	stdx509 "crypto/x509"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
+	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/pocketbase/pocketbase/models"
 
 	"golang.org/x/exp/slices"
@@ -17,6 +21,7 @@ import (
 	"certimate/internal/domain"
 	"certimate/internal/utils/app"
 
+	coredeployer "certimate/internal/pkg/core/deployer"
 	"certimate/internal/pkg/utils/x509"
 )
 
@@ -26,6 +31,7 @@ const (
 	checkPhase  Phase = "check"
 	applyPhase  Phase = "apply"
 	deployPhase Phase = "deploy"
+	revokePhase Phase = "revoke"
 )
 
 const validityDuration = time.Hour * 24 * 10
@@ -36,6 +42,11 @@ func deploy(ctx context.Context, record *models.Record) error {
 			app.GetApp().Logger().Error("部署失败", "err", r)
 		}
 	}()
+
+	// 同一域名的部署依次进行，预检在部署期间被拒绝
+	unlock := domainLocks.lock(record.Id)
+	defer unlock()
+
 	var certificate *applicant.Certificate
 
 	history := NewHistory(record)
@@ -52,33 +63,68 @@ func deploy(ctx context.Context, record *models.Record) error {
 	}
 	history.record(checkPhase, "获取记录成功", nil)
 
+	applyConfig := &domain.ApplyConfig{}
+	currRecord.UnmarshalJSONField("applyConfig", applyConfig)
+	history.record(checkPhase, "获取验证方式成功", &RecordInfo{
+		Info: []string{fmt.Sprintf("验证方式: %s", applyConfig.GetChallengeType())},
+	})
+
 	cert := currRecord.GetString("certificate")
 	expiredAt := currRecord.GetDateTime("expiredAt").Time()
 
-	// 检查证书是否包含设置的所有域名
-	changed := isCertChanged(cert, currRecord)
+	// 外部导入的证书不申请也不续期，只部署
+	external := currRecord.GetBool("external")
 
-	if cert != "" && time.Until(expiredAt) > validityDuration && currRecord.GetBool("deployed") && !changed {
+	changed, renew, renewReason := false, false, "外部导入的证书，不自动续期"
+	if !external {
+		// 检查证书是否包含设置的所有域名
+		changed = isCertChanged(cert, currRecord)
+
+		// 检查证书是否需要续期
+		renew, renewReason = checkRenewal(currRecord, applyConfig)
+	}
+
+	if !renew && currRecord.GetBool("deployed") && !changed {
 		app.GetApp().Logger().Info("证书在有效期内")
 		history.record(checkPhase, "证书在有效期内且已部署，跳过", &RecordInfo{
-			Info: []string{fmt.Sprintf("证书有效期至 %s", expiredAt.Format("2006-01-02"))},
+			Info: []string{fmt.Sprintf("证书有效期至 %s", expiredAt.Format("2006-01-02")), renewReason},
 		}, true)
 
 		// 跳过的情况也算成功
 		history.setWholeSuccess(true)
 		return nil
 	}
-	history.record(checkPhase, "检查通过", nil, true)
+	// 需要申请时检查 DNS 别名的 CNAME 是否已生效，避免完整申请后才发现配置错误
+	if !external && (renew || changed) {
+		aliasInfo, err := applicant.CheckDnsAliases(applyConfig, currRecord.GetString("domain"))
+		if err != nil {
+			history.record(checkPhase, "检查 DNS 别名失败", &RecordInfo{Err: err, Info: aliasInfo})
+			app.GetApp().Logger().Error("检查 DNS 别名失败", "err", err)
+			return err
+		}
+		if len(aliasInfo) > 0 {
+			history.record(checkPhase, "检查 DNS 别名成功", &RecordInfo{Info: aliasInfo})
+		}
+	}
+
+	history.record(checkPhase, "检查通过", &RecordInfo{Info: []string{renewReason}}, true)
 
 	// ############2.申请证书
-	history.record(applyPhase, "开始申请", nil)
+	history.record(applyPhase, "开始申请", &RecordInfo{
+		Info: []string{fmt.Sprintf("验证方式: %s", applyConfig.GetChallengeType())},
+	})
 
-	if cert != "" && time.Until(expiredAt) > validityDuration && !changed {
-		history.record(applyPhase, "证书在有效期内，跳过", &RecordInfo{
+	if external {
+		history.record(applyPhase, "外部导入的证书，跳过", &RecordInfo{
 			Info: []string{fmt.Sprintf("证书有效期至 %s", expiredAt.Format("2006-01-02"))},
 		})
+	} else if !renew && !changed {
+		history.record(applyPhase, "证书在有效期内，跳过", &RecordInfo{
+			Info: []string{fmt.Sprintf("证书有效期至 %s", expiredAt.Format("2006-01-02")), renewReason},
+		})
 	} else {
-		applicant, err := applicant.Get(currRecord)
+		applyLogger := &checkpointLogger{DefaultLogger: coredeployer.NewDefaultLogger(), history: history}
+		applicant, err := applicant.GetWithLogger(currRecord, applyLogger)
 		if err != nil {
 			history.record(applyPhase, "获取applicant失败", &RecordInfo{Err: err})
 			app.GetApp().Logger().Error("获取applicant失败", "err", err)
@@ -86,13 +132,23 @@ func deploy(ctx context.Context, record *models.Record) error {
 		}
 		certificate, err = applicant.Apply()
 		if err != nil {
-			history.record(applyPhase, "申请证书失败", &RecordInfo{Err: err})
+			history.record(applyPhase, "申请证书失败", &RecordInfo{Err: err, Info: applyLogger.GetRecords()})
 			app.GetApp().Logger().Error("申请证书失败", "err", err)
 			return err
 		}
 		history.record(applyPhase, "申请证书成功", &RecordInfo{
-			Info: []string{fmt.Sprintf("证书地址: %s", certificate.CertUrl)},
+			Info: append(applyLogger.GetRecords(),
+				fmt.Sprintf("签发 CA: %s", certificate.Ca),
+				fmt.Sprintf("证书地址: %s", certificate.CertUrl),
+			),
 		})
+		if meta, err := domain.ParseCertificateMeta(certificate.Certificate); err == nil {
+			chainInfo := []string{fmt.Sprintf("证书链根证书: %s", meta.ChainRoot)}
+			if applyConfig.PreferredChain != "" && applyConfig.PreferredChain != meta.ChainRoot {
+				chainInfo = append(chainInfo, fmt.Sprintf("CA 未提供首选证书链 %s，已使用默认证书链", applyConfig.PreferredChain))
+			}
+			history.record(applyPhase, "获取证书链成功", &RecordInfo{Info: chainInfo})
+		}
 		history.setCert(certificate)
 	}
 
@@ -135,6 +191,22 @@ func deploy(ctx context.Context, record *models.Record) error {
 	return nil
 }
 
+// 申请证书的日志记录器，支持在申请过程中提前保存部署记录，
+// 手动 DNS 验证等待添加记录时，管理员可在部署记录中看到需要添加的 TXT 记录。
+type checkpointLogger struct {
+	*coredeployer.DefaultLogger
+	history *history
+}
+
+func (l *checkpointLogger) Checkpoint() {
+	l.history.record(applyPhase, "等待验证", &RecordInfo{Info: l.GetRecords()})
+	l.FlushRecords()
+
+	if _, err := l.history.save(); err != nil {
+		app.GetApp().Logger().Error("保存部署记录失败", "err", err)
+	}
+}
+
 func isCertChanged(certificate string, record *models.Record) bool {
 	// 如果证书为空，直接返回true
 	if certificate == "" {
@@ -148,17 +220,41 @@ func isCertChanged(certificate string, record *models.Record) bool {
 		return true
 	}
 
-	// 遍历域名列表，检查是否都在证书中，找到第一个不存在证书中域名时提前返回true
-	for _, domain := range strings.Split(record.GetString("domain"), ";") {
-		if !slices.Contains(cert.DNSNames, domain) && !slices.Contains(cert.DNSNames, "*."+removeLastSubdomain(domain)) {
-			return true
-		}
+	// 检查域名列表是否都在证书中
+	if _, ok := findUncoveredDomain(cert, record.GetString("domain")); ok {
+		return true
 	}
 
 	// 解析applyConfig
 	applyConfig := &domain.ApplyConfig{}
 	record.UnmarshalJSONField("applyConfig", applyConfig)
 
+	// 检查主 CA 是否变更，历史记录中没有 CA 信息时不视为变更。
+	// 由备用 CA 签发的证书仅在主 CA 与签发时相同且该备用 CA 仍在配置中时不视为变更，
+	// 更换主 CA 后即使原 CA 仍是备用 CA 也重新申请
+	if issuedCa := record.GetString("ca"); issuedCa != "" {
+		if changed, err := isCAChanged(issuedCa, record.GetString("primaryCa"), applyConfig); err != nil {
+			app.GetApp().Logger().Error("获取 CA 失败", "err", err)
+		} else if changed {
+			return true
+		}
+	}
+
+	// 使用上传的 CSR 签发时，检查 CSR 是否变更，证书加密算法由 CSR 决定
+	if applyConfig.GetKeyMode() == domain.KeyModeCSR {
+		csr, err := certcrypto.PemDecodeTox509CSR([]byte(applyConfig.Csr))
+		if err != nil {
+			app.GetApp().Logger().Error("解析 CSR 失败", "err", err)
+			return true
+		}
+
+		if pubkey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !pubkey.Equal(csr.PublicKey) {
+			return true
+		}
+
+		return false
+	}
+
 	// 检查证书加密算法是否变更
 	switch pubkey := cert.PublicKey.(type) {
 	case *rsa.PublicKey:
@@ -204,6 +300,64 @@ func isCertChanged(certificate string, record *models.Record) bool {
 	return false
 }
 
+// 检查证书的签发 CA 与当前申请配置是否一致。
+//
+// 入参：
+//   - issuedCa: 签发证书的 CA。
+//   - issuedPrimaryCa: 签发时的主 CA，旧版本签发的证书为空。
+//   - applyConfig: 域名的申请配置。
+//
+// 出参：
+//   - 是否变更。
+//   - 错误。
+func isCAChanged(issuedCa, issuedPrimaryCa string, applyConfig *domain.ApplyConfig) (bool, error) {
+	primaryCa, err := applicant.GetCA(applyConfig)
+	if err != nil {
+		return false, err
+	}
+
+	if issuedCa == primaryCa {
+		return false, nil
+	}
+
+	if issuedPrimaryCa != primaryCa {
+		return true, nil
+	}
+
+	cas, err := applicant.GetCandidateCAs(applyConfig)
+	if err != nil {
+		return false, err
+	}
+
+	return !slices.Contains(cas, issuedCa), nil
+}
+
+// 查找域名列表中第一个不在证书中的域名或 IP 地址，域名可由通配符证书覆盖。
+//
+// 入参：
+//   - cert: 证书。
+//   - domains: 以分号分隔的域名或 IP 地址。
+//
+// 出参：
+//   - 不在证书中的域名或 IP 地址。
+//   - 是否存在不在证书中的域名或 IP 地址。
+func findUncoveredDomain(cert *stdx509.Certificate, domains string) (string, bool) {
+	for _, domain := range strings.Split(domains, ";") {
+		if ip := net.ParseIP(domain); ip != nil {
+			if !slices.ContainsFunc(cert.IPAddresses, ip.Equal) {
+				return domain, true
+			}
+			continue
+		}
+
+		if !slices.Contains(cert.DNSNames, domain) && !slices.Contains(cert.DNSNames, "*."+removeLastSubdomain(domain)) {
+			return domain, true
+		}
+	}
+
+	return "", false
+}
+
 func removeLastSubdomain(domain string) string {
 	parts := strings.Split(domain, ".")
 	if len(parts) > 1 {
@@ -15,8 +15,9 @@ func create(ctx context.Context, record *models.Record) error {
 	}
 
 	if record.GetBool("rightnow") {
+		// 部署在请求结束后继续进行，手动 DNS 验证等场景可能持续数小时，不能随请求取消
 		go func() {
-			if err := deploy(ctx, record); err != nil {
+			if err := deploy(context.WithoutCancel(ctx), record); err != nil {
 				app.GetApp().Logger().Error("deploy failed", "err", err)
 			}
 		}()
@@ -25,7 +26,7 @@ func create(ctx context.Context, record *models.Record) error {
 	scheduler := app.GetScheduler()
 
 	err := scheduler.Add(record.Id, record.GetString("crontab"), func() {
-		deploy(ctx, record)
+		deploy(context.WithoutCancel(ctx), record)
 	})
 	if err != nil {
 		app.GetApp().Logger().Error("add cron job failed", "err", err)
@@ -45,15 +46,16 @@ func update(ctx context.Context, record *models.Record) error {
 	}
 
 	if record.GetBool("rightnow") {
+		// 部署在请求结束后继续进行，手动 DNS 验证等场景可能持续数小时，不能随请求取消
 		go func() {
-			if err := deploy(ctx, record); err != nil {
+			if err := deploy(context.WithoutCancel(ctx), record); err != nil {
 				app.GetApp().Logger().Error("deploy failed", "err", err)
 			}
 		}()
 	}
 
 	err := scheduler.Add(record.Id, record.GetString("crontab"), func() {
-		deploy(ctx, record)
+		deploy(context.WithoutCancel(ctx), record)
 	})
 	if err != nil {
 		app.GetApp().Logger().Error("update cron job failed", "err", err)
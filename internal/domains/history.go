@@ -6,6 +6,7 @@ import (
 	"github.com/pocketbase/pocketbase/models"
 
 	"certimate/internal/applicant"
+	"certimate/internal/domain"
 	"certimate/internal/utils/app"
 	"certimate/internal/utils/xtime"
 )
@@ -23,6 +24,8 @@ type RecordInfo struct {
 }
 
 type history struct {
+	// 已保存的部署记录 ID，申请过程中提前保存过时不为空
+	id           string
 	Domain       string                  `json:"domain"`
 	Log          map[Phase][]historyItem `json:"log"`
 	Phase        Phase                   `json:"phase"`
@@ -30,6 +33,14 @@ type history struct {
 	DeployedAt   string                  `json:"deployedAt"`
 	Cert         *applicant.Certificate  `json:"cert"`
 	WholeSuccess bool                    `json:"wholeSuccess"`
+	Revoked      *revokedCertificate     `json:"revoked"`
+}
+
+type revokedCertificate struct {
+	Certificate string `json:"certificate"`
+	Ca          string `json:"ca"`
+	// 是否为域名当前使用的证书
+	Current bool `json:"current"`
 }
 
 func NewHistory(record *models.Record) *history {
@@ -69,29 +80,24 @@ func (a *history) setCert(cert *applicant.Certificate) {
 	a.Cert = cert
 }
 
+func (a *history) setRevoked(certificate, ca string, current bool) {
+	a.Revoked = &revokedCertificate{
+		Certificate: certificate,
+		Ca:          ca,
+		Current:     current,
+	}
+}
+
 func (a *history) setWholeSuccess(success bool) {
 	a.WholeSuccess = success
 }
 
 func (a *history) commit() error {
-	collection, err := app.GetApp().Dao().FindCollectionByNameOrId("deployments")
+	record, err := a.save()
 	if err != nil {
 		return err
 	}
 
-	record := models.NewRecord(collection)
-
-	record.Set("domain", a.Domain)
-	record.Set("deployedAt", a.DeployedAt)
-	record.Set("log", a.Log)
-	record.Set("phase", string(a.Phase))
-	record.Set("phaseSuccess", a.PhaseSuccess)
-	record.Set("wholeSuccess", a.WholeSuccess)
-
-	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
-		return err
-	}
-
 	domainRecord, err := app.GetApp().Dao().FindRecordById("domains", a.Domain)
 	if err != nil {
 		return err
@@ -103,6 +109,9 @@ func (a *history) commit() error {
 	if a.Phase == deployPhase && a.PhaseSuccess {
 		domainRecord.Set("deployed", true)
 	}
+	if a.Revoked != nil && a.Revoked.Current {
+		domainRecord.Set("revoked", true)
+	}
 	cert := a.Cert
 	if cert != nil {
 		domainRecord.Set("certUrl", cert.CertUrl)
@@ -111,7 +120,17 @@ func (a *history) commit() error {
 		domainRecord.Set("certificate", cert.Certificate)
 		domainRecord.Set("issuerCertificate", cert.IssuerCertificate)
 		domainRecord.Set("csr", cert.Csr)
-		domainRecord.Set("expiredAt", time.Now().Add(time.Hour*24*90))
+		domainRecord.Set("ca", cert.Ca)
+		domainRecord.Set("primaryCa", cert.PrimaryCa)
+		domainRecord.Set("untrusted", cert.Untrusted)
+		domainRecord.Set("revoked", false)
+
+		meta, err := domain.ParseCertificateMeta(cert.Certificate)
+		if err != nil {
+			app.GetApp().Logger().Error("解析证书失败", "err", err)
+		} else {
+			setCertificateMeta(domainRecord, meta)
+		}
 	}
 
 	if err := app.GetApp().Dao().SaveRecord(domainRecord); err != nil {
@@ -120,3 +139,53 @@ func (a *history) commit() error {
 
 	return nil
 }
+
+// 保存部署记录，已保存过时更新原记录。
+func (a *history) save() (*models.Record, error) {
+	var record *models.Record
+	if a.id != "" {
+		existing, err := app.GetApp().Dao().FindRecordById("deployments", a.id)
+		if err != nil {
+			return nil, err
+		}
+		record = existing
+	} else {
+		collection, err := app.GetApp().Dao().FindCollectionByNameOrId("deployments")
+		if err != nil {
+			return nil, err
+		}
+		record = models.NewRecord(collection)
+	}
+
+	record.Set("domain", a.Domain)
+	record.Set("deployedAt", a.DeployedAt)
+	record.Set("log", a.Log)
+	record.Set("phase", string(a.Phase))
+	record.Set("phaseSuccess", a.PhaseSuccess)
+	record.Set("wholeSuccess", a.WholeSuccess)
+	if a.Cert != nil {
+		record.Set("certificate", a.Cert.Certificate)
+		record.Set("ca", a.Cert.Ca)
+	} else if a.Revoked != nil {
+		record.Set("certificate", a.Revoked.Certificate)
+		record.Set("ca", a.Revoked.Ca)
+	}
+
+	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+		return nil, err
+	}
+	a.id = record.Id
+
+	return record, nil
+}
+
+func setCertificateMeta(record *models.Record, meta *domain.CertificateMeta) {
+	record.Set("expiredAt", meta.ExpiredAt)
+	record.Set("issuedAt", meta.IssuedAt)
+	record.Set("serialNumber", meta.SerialNumber)
+	record.Set("issuer", meta.Issuer)
+	record.Set("sans", meta.SANs)
+	record.Set("keyAlgorithm", meta.KeyAlgorithm)
+	record.Set("fingerprint", meta.Fingerprint)
+	record.Set("chainRoot", meta.ChainRoot)
+}
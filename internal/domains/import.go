@@ -0,0 +1,147 @@
+package domains
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pocketbase/pocketbase/models"
+
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
+	"certimate/internal/utils/app"
+)
+
+const defaultImportCrontab = "0 0 * * *"
+
+// 导入外部签发的证书。
+// 证书保存在标记为外部证书的域名记录上，部署时跳过申请阶段，只执行部署阶段；到期通知与其他证书一致。
+// 未指定域名记录时，按证书中的域名新建一条域名记录。
+func (s *DomainService) Import(ctx context.Context, req *domain.DomainImportReq) (*domain.DomainImportResp, error) {
+	certPem, privkeyPem, err := parseImportCertificate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验私钥与证书是否匹配
+	if _, err := tls.X509KeyPair([]byte(certPem), []byte(privkeyPem)); err != nil {
+		return nil, fmt.Errorf("private key does not match certificate: %w", err)
+	}
+
+	certs, err := x509.ParseCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := domain.ParseCertificateMeta(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	var record *models.Record
+	if req.DomainId != "" {
+		record, err = app.GetApp().Dao().FindRecordById("domains", req.DomainId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get domain: %w", err)
+		}
+
+		// 与部署时的检查一致，证书需包含域名记录中的所有域名，否则下次部署会被视为证书变更
+		if name, ok := findUncoveredDomain(certs[0], record.GetString("domain")); ok {
+			return nil, fmt.Errorf("certificate does not cover %s of domain %s", name, record.GetString("domain"))
+		}
+	} else {
+		collection, err := app.GetApp().Dao().FindCollectionByNameOrId("domains")
+		if err != nil {
+			return nil, err
+		}
+
+		names := slices.Clone(certs[0].DNSNames)
+		for _, ip := range certs[0].IPAddresses {
+			names = append(names, ip.String())
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("certificate does not contain any domain or ip address")
+		}
+
+		record = models.NewRecord(collection)
+		record.Set("domain", strings.Join(names, ";"))
+		record.Set("crontab", defaultImportCrontab)
+		record.Set("enabled", true)
+	}
+
+	// 证书链中除第一张证书外的部分作为颁发者证书
+	issuerPem := ""
+	if idx := strings.Index(certPem, "-----END CERTIFICATE-----"); idx >= 0 {
+		issuerPem = strings.TrimSpace(certPem[idx+len("-----END CERTIFICATE-----"):])
+	}
+
+	record.Set("external", true)
+	record.Set("certificate", certPem)
+	record.Set("privateKey", privkeyPem)
+	record.Set("issuerCertificate", issuerPem)
+	record.Set("certUrl", "")
+	record.Set("certStableUrl", "")
+	record.Set("csr", "")
+	record.Set("ca", "")
+	record.Set("primaryCa", "")
+	record.Set("untrusted", false)
+	record.Set("revoked", false)
+	record.Set("deployed", false)
+	record.Set("rightnow", req.Deploy)
+	setCertificateMeta(record, meta)
+
+	isNew := record.IsNew()
+	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+		return nil, fmt.Errorf("failed to save domain: %w", err)
+	}
+
+	// 部署在请求结束后异步执行，不能使用请求的上下文
+	if isNew {
+		err = create(context.Background(), record)
+	} else {
+		err = update(context.Background(), record)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DomainImportResp{DomainId: record.Id}, nil
+}
+
+func parseImportCertificate(req *domain.DomainImportReq) (string, string, error) {
+	switch req.Format {
+	case "", domain.CertificateFormatPEM:
+		privkeyPem, err := x509.TransformPrivateKeyFromPEMToLegoPEM(req.PrivateKey)
+		if err != nil {
+			return "", "", err
+		}
+
+		return strings.TrimSpace(req.Certificate) + "\n", privkeyPem, nil
+
+	case domain.CertificateFormatPFX:
+		data, err := base64.StdEncoding.DecodeString(req.Data)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode pfx data: %w", err)
+		}
+
+		return x509.TransformCertificateFromPFXToPEM(data, req.Password)
+
+	case domain.CertificateFormatJKS:
+		data, err := base64.StdEncoding.DecodeString(req.Data)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode jks data: %w", err)
+		}
+
+		keypass := req.JksKeypass
+		if keypass == "" {
+			keypass = req.Password
+		}
+
+		return x509.TransformCertificateFromJKSToPEM(data, req.JksAlias, keypass, req.Password)
+	}
+
+	return "", "", fmt.Errorf("unsupported certificate format: %s", req.Format)
+}
@@ -2,7 +2,9 @@ package domains
 
 import (
 	"context"
+	"slices"
 
+	"certimate/internal/applicant"
 	"certimate/internal/notify"
 	"certimate/internal/utils/app"
 )
@@ -15,6 +17,21 @@ func InitSchedule() {
 		return
 	}
 
+	// 服务重启前等待手动 DNS 验证的域名重新申请，CA 复用未完成的质询时沿用已添加或已确认的记录
+	resumable, err := applicant.NewManualDnsService().ListResumable(context.Background())
+	if err != nil {
+		app.GetApp().Logger().Error("查询等待手动 DNS 验证的域名失败", "err", err)
+	}
+	for _, record := range records {
+		if slices.Contains(resumable, record.Id) {
+			go func() {
+				if err := deploy(context.Background(), record); err != nil {
+					app.GetApp().Logger().Error("继续手动 DNS 验证失败", "err", err)
+				}
+			}()
+		}
+	}
+
 	// 加入到定时任务
 	for _, record := range records {
 		if err := app.GetScheduler().Add(record.Id, record.GetString("crontab"), func() {
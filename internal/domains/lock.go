@@ -0,0 +1,37 @@
+package domains
+
+import (
+	"sync"
+)
+
+// 按域名记录加锁，部署与预检共用。
+// 预检会在真实的 _acme-challenge 名称下写入并清除测试 TXT 记录，与部署同时进行时可能清除部署中的质询记录。
+// 锁的数量不超过域名记录数，因此不做回收。
+var domainLocks = &domainLockMap{}
+
+type domainLockMap struct {
+	locks sync.Map
+}
+
+func (m *domainLockMap) get(id string) *sync.Mutex {
+	l, _ := m.locks.LoadOrStore(id, &sync.Mutex{})
+	return l.(*sync.Mutex)
+}
+
+// 加锁，已被占用时等待，返回解锁函数。
+func (m *domainLockMap) lock(id string) func() {
+	l := m.get(id)
+	l.Lock()
+
+	return l.Unlock
+}
+
+// 尝试加锁，已被占用时立即返回 false。
+func (m *domainLockMap) tryLock(id string) (func(), bool) {
+	l := m.get(id)
+	if !l.TryLock() {
+		return nil, false
+	}
+
+	return l.Unlock, true
+}
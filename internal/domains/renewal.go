@@ -0,0 +1,83 @@
+package domains
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/pocketbase/models"
+
+	"certimate/internal/applicant"
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
+	"certimate/internal/utils/app"
+)
+
+// 判断证书是否需要续期。
+// 优先使用 CA 通过 ARI（RFC 9773）建议的续期时间窗口，并将其保存在域名记录上；
+// CA 不支持 ARI 或查询失败时，按申请配置中的有效期比例判断；未配置比例时，在到期前 10 天内续期，
+// 有效期较短的证书（如 6 天的短期证书）在剩余有效期不足一半时续期。
+//
+// 入参：
+//   - record: 域名记录。
+//   - applyConfig: 域名的申请配置。
+//
+// 出参：
+//   - 是否需要续期。
+//   - 判断依据，用于记录到部署历史中。
+func checkRenewal(record *models.Record, applyConfig *domain.ApplyConfig) (bool, string) {
+	certPem := record.GetString("certificate")
+	if certPem == "" {
+		return true, "证书不存在"
+	}
+
+	if record.GetBool("revoked") {
+		return true, "证书已被吊销"
+	}
+
+	cert, err := x509.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return true, fmt.Sprintf("解析证书失败: %v", err)
+	}
+
+	now := time.Now()
+
+	renewalInfo, err := applicant.GetRenewalInfo(record)
+	if err == nil {
+		record.Set("renewalWindowStart", renewalInfo.SuggestedWindow.Start)
+		record.Set("renewalWindowEnd", renewalInfo.SuggestedWindow.End)
+		if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+			app.GetApp().Logger().Error("保存续期时间窗口失败", "err", err)
+		}
+
+		window := fmt.Sprintf("%s ~ %s", renewalInfo.SuggestedWindow.Start.Format(time.DateTime), renewalInfo.SuggestedWindow.End.Format(time.DateTime))
+		if renewalInfo.ShouldRenewAt(now, 0) != nil {
+			return true, fmt.Sprintf("已到达 CA 建议的续期时间窗口: %s", window)
+		}
+
+		return false, fmt.Sprintf("CA 建议的续期时间窗口: %s", window)
+	}
+
+	app.GetApp().Logger().Warn("查询 ARI 续期时间窗口失败，按证书有效期判断", "err", err)
+
+	if applyConfig.RenewalPercentage > 0 && applyConfig.RenewalPercentage < 100 {
+		lifetime := cert.NotAfter.Sub(cert.NotBefore)
+		renewAt := cert.NotBefore.Add(lifetime * time.Duration(applyConfig.RenewalPercentage) / 100)
+		if now.After(renewAt) {
+			return true, fmt.Sprintf("证书已使用超过有效期的 %d%%", applyConfig.RenewalPercentage)
+		}
+
+		return false, fmt.Sprintf("证书将于 %s 续期", renewAt.Format(time.DateTime))
+	}
+
+	renewBefore := getRenewBefore(cert.NotAfter.Sub(cert.NotBefore))
+	if time.Until(cert.NotAfter) <= renewBefore {
+		return true, "证书即将到期"
+	}
+
+	return false, fmt.Sprintf("证书将于 %s 续期", cert.NotAfter.Add(-renewBefore).Format(time.DateTime))
+}
+
+// 获取证书到期前多久续期，不超过证书有效期的一半。
+func getRenewBefore(lifetime time.Duration) time.Duration {
+	return domain.ScaleCertificateWindow(validityDuration, lifetime)
+}
@@ -0,0 +1,108 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+
+	"certimate/internal/applicant"
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+)
+
+type DomainService struct{}
+
+func NewDomainService() *DomainService {
+	return &DomainService{}
+}
+
+// 吊销域名当前或历史部署中的证书，并写入部署历史。
+// 吊销的是当前证书时，域名会被标记为已吊销，下次部署时将重新申请证书。
+func (s *DomainService) Revoke(ctx context.Context, req *domain.DomainRevokeReq) error {
+	// 与部署互斥，避免部署中替换证书后吊销记录写回旧证书的状态
+	unlock := domainLocks.lock(req.DomainId)
+	defer unlock()
+
+	record, err := app.GetApp().Dao().FindRecordById("domains", req.DomainId)
+	if err != nil {
+		return fmt.Errorf("failed to get domain: %w", err)
+	}
+
+	if record.GetBool("external") {
+		return fmt.Errorf("certificate of domain %s is imported externally and cannot be revoked by certimate", record.GetString("domain"))
+	}
+
+	certificate := record.GetString("certificate")
+	ca := record.GetString("ca")
+	if req.DeploymentId != "" {
+		deployment, err := app.GetApp().Dao().FindRecordById("deployments", req.DeploymentId)
+		if err != nil {
+			return fmt.Errorf("failed to get deployment: %w", err)
+		}
+
+		if deployment.GetString("domain") != record.Id {
+			return fmt.Errorf("deployment %s does not belong to domain %s", deployment.Id, record.Id)
+		}
+
+		certificate = deployment.GetString("certificate")
+		ca = deployment.GetString("ca")
+	}
+
+	if certificate == "" {
+		return fmt.Errorf("no certificate to revoke")
+	}
+
+	current := certificate == record.GetString("certificate")
+
+	history := NewHistory(record)
+	history.record(revokePhase, "开始吊销", &RecordInfo{
+		Info: []string{fmt.Sprintf("吊销原因: %d", req.Reason)},
+	})
+
+	if err := applicant.Revoke(record, certificate, ca, req.Reason); err != nil {
+		app.GetApp().Logger().Error("吊销证书失败", "err", err)
+		history.record(revokePhase, "吊销证书失败", &RecordInfo{Err: err})
+		if err := history.commit(); err != nil {
+			app.GetApp().Logger().Error("保存部署历史失败", "err", err)
+		}
+		return err
+	}
+
+	history.record(revokePhase, "吊销证书成功", nil, true)
+	history.setRevoked(certificate, ca, current)
+	history.setWholeSuccess(true)
+	if err := history.commit(); err != nil {
+		return fmt.Errorf("failed to save deployment history: %w", err)
+	}
+
+	if req.Reissue {
+		// 重新部署在吊销结束释放锁后开始
+		go func() {
+			if err := deploy(context.Background(), record); err != nil {
+				app.GetApp().Logger().Error("deploy failed", "err", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// 申请证书前检查域名的解析、CAA 记录、CNAME 及 DNS 服务商授权，返回预检报告。
+func (s *DomainService) Preflight(ctx context.Context, req *domain.DomainPreflightReq) (*domain.DomainPreflightReport, error) {
+	record, err := app.GetApp().Dao().FindRecordById("domains", req.DomainId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain: %w", err)
+	}
+
+	if record.GetBool("external") {
+		return nil, fmt.Errorf("certificate of domain %s is imported externally and is not issued by certimate", record.GetString("domain"))
+	}
+
+	// 预检写入的测试 TXT 记录与质询记录同名，部署期间执行可能清除部署中的质询记录
+	unlock, ok := domainLocks.tryLock(record.Id)
+	if !ok {
+		return nil, fmt.Errorf("domain %s is being deployed, please try again later", record.GetString("domain"))
+	}
+	defer unlock()
+
+	return applicant.Preflight(record)
+}
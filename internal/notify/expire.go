@@ -8,6 +8,8 @@ import (
 	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/models"
 
+	"certimate/internal/domain"
+	"certimate/internal/pkg/utils/x509"
 	"certimate/internal/utils/app"
 	"certimate/internal/utils/xtime"
 )
@@ -15,16 +17,20 @@ import (
 const (
 	defaultExpireSubject = "您有 {COUNT} 张证书即将过期"
 	defaultExpireMessage = "有 {COUNT} 张证书即将过期，域名分别为 {DOMAINS}，请保持关注！"
+
+	// 到期前多久提醒，有效期较短的证书按有效期缩放
+	expireNoticeWindow = 24 * time.Hour * 15
 )
 
 func PushExpireMsg() {
 	// 查询即将过期的证书
-	records, err := app.GetApp().Dao().FindRecordsByFilter("domains", "expiredAt<{:time}&&certUrl!=''", "-created", 500, 0,
-		dbx.Params{"time": xtime.GetTimeAfter(24 * time.Hour * 15)})
+	records, err := app.GetApp().Dao().FindRecordsByFilter("domains", "expiredAt<{:time}&&certificate!=''", "-created", 500, 0,
+		dbx.Params{"time": xtime.GetTimeAfter(expireNoticeWindow)})
 	if err != nil {
 		app.GetApp().Logger().Error("find expired domains by filter", "error", err)
 		return
 	}
+	records = filterExpiring(records, time.Now())
 
 	// 组装消息
 	msg := buildMsg(records)
@@ -38,6 +44,26 @@ func PushExpireMsg() {
 	}
 }
 
+// 按证书有效期筛选进入提醒窗口的记录，避免短期证书每天都触发提醒。
+func filterExpiring(records []*models.Record, now time.Time) []*models.Record {
+	expiring := make([]*models.Record, 0, len(records))
+	for _, record := range records {
+		cert, err := x509.ParseCertificateFromPEM(record.GetString("certificate"))
+		if err != nil {
+			// 无法解析证书时按到期时间提醒
+			expiring = append(expiring, record)
+			continue
+		}
+
+		window := domain.ScaleCertificateWindow(expireNoticeWindow, cert.NotAfter.Sub(cert.NotBefore))
+		if cert.NotAfter.Sub(now) <= window {
+			expiring = append(expiring, record)
+		}
+	}
+
+	return expiring
+}
+
 type notifyTemplates struct {
 	NotifyTemplates []notifyTemplate `json:"notifyTemplates"`
 }
@@ -1,18 +1,14 @@
 package ssh
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
 
 	xerrors "github.com/pkg/errors"
-	"github.com/pkg/sftp"
-	"golang.org/x/crypto/ssh"
 
 	"certimate/internal/pkg/core/deployer"
+	sshutil "certimate/internal/pkg/utils/ssh"
 	"certimate/internal/pkg/utils/x509"
 )
 
@@ -83,7 +79,7 @@ func NewWithLogger(config *SshDeployerConfig, logger deployer.Logger) (*SshDeplo
 
 func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
 	// 连接
-	client, err := createSshClient(
+	client, err := sshutil.NewClient(
 		d.config.SshHost,
 		d.config.SshPort,
 		d.config.SshUsername,
@@ -100,7 +96,7 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 
 	// 执行前置命令
 	if d.config.PreCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PreCommand)
+		stdout, stderr, err := sshutil.ExecCommand(client, d.config.PreCommand)
 		if err != nil {
 			return nil, xerrors.Wrapf(err, "failed to run pre-command: stdout: %s, stderr: %s", stdout, stderr)
 		}
@@ -111,13 +107,13 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 	// 上传证书和私钥文件
 	switch d.config.OutputFormat {
 	case OUTPUT_FORMAT_PEM:
-		if err := writeSftpFileString(client, d.config.OutputCertPath, certPem); err != nil {
+		if err := sshutil.WriteSftpFileString(client, d.config.OutputCertPath, certPem); err != nil {
 			return nil, xerrors.Wrap(err, "failed to upload certificate file")
 		}
 
 		d.logger.Logt("certificate file uploaded")
 
-		if err := writeSftpFileString(client, d.config.OutputKeyPath, privkeyPem); err != nil {
+		if err := sshutil.WriteSftpFileString(client, d.config.OutputKeyPath, privkeyPem); err != nil {
 			return nil, xerrors.Wrap(err, "failed to upload private key file")
 		}
 
@@ -131,7 +127,7 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 
 		d.logger.Logt("certificate transformed to PFX")
 
-		if err := writeSftpFile(client, d.config.OutputCertPath, pfxData); err != nil {
+		if err := sshutil.WriteSftpFile(client, d.config.OutputCertPath, pfxData); err != nil {
 			return nil, xerrors.Wrap(err, "failed to upload certificate file")
 		}
 
@@ -145,7 +141,7 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 
 		d.logger.Logt("certificate transformed to JKS")
 
-		if err := writeSftpFile(client, d.config.OutputCertPath, jksData); err != nil {
+		if err := sshutil.WriteSftpFile(client, d.config.OutputCertPath, jksData); err != nil {
 			return nil, xerrors.Wrap(err, "failed to upload certificate file")
 		}
 
@@ -157,7 +153,7 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 
 	// 执行后置命令
 	if d.config.PostCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PostCommand)
+		stdout, stderr, err := sshutil.ExecCommand(client, d.config.PostCommand)
 		if err != nil {
 			return nil, xerrors.Wrapf(err, "failed to run command, stdout: %s, stderr: %s", stdout, stderr)
 		}
@@ -167,86 +163,3 @@ func (d *SshDeployer) Deploy(ctx context.Context, certPem string, privkeyPem str
 
 	return &deployer.DeployResult{}, nil
 }
-
-func createSshClient(host string, port int32, username string, password string, key string, keyPassphrase string) (*ssh.Client, error) {
-	if host == "" {
-		host = "localhost"
-	}
-
-	if port == 0 {
-		port = 22
-	}
-
-	var authMethod ssh.AuthMethod
-	if key != "" {
-		var signer ssh.Signer
-		var err error
-
-		if keyPassphrase != "" {
-			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(keyPassphrase))
-		} else {
-			signer, err = ssh.ParsePrivateKey([]byte(key))
-		}
-
-		if err != nil {
-			return nil, err
-		}
-		authMethod = ssh.PublicKeys(signer)
-	} else {
-		authMethod = ssh.Password(password)
-	}
-
-	return ssh.Dial("tcp", fmt.Sprintf("%s:%d", host, port), &ssh.ClientConfig{
-		User:            username,
-		Auth:            []ssh.AuthMethod{authMethod},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-	})
-}
-
-func execSshCommand(sshCli *ssh.Client, command string) (string, string, error) {
-	session, err := sshCli.NewSession()
-	if err != nil {
-		return "", "", err
-	}
-
-	defer session.Close()
-	var stdoutBuf bytes.Buffer
-	session.Stdout = &stdoutBuf
-	var stderrBuf bytes.Buffer
-	session.Stderr = &stderrBuf
-	err = session.Run(command)
-	if err != nil {
-		return "", "", err
-	}
-
-	return stdoutBuf.String(), stderrBuf.String(), nil
-}
-
-func writeSftpFileString(sshCli *ssh.Client, path string, content string) error {
-	return writeSftpFile(sshCli, path, []byte(content))
-}
-
-func writeSftpFile(sshCli *ssh.Client, path string, data []byte) error {
-	sftpCli, err := sftp.NewClient(sshCli)
-	if err != nil {
-		return xerrors.Wrap(err, "failed to create sftp client")
-	}
-	defer sftpCli.Close()
-
-	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
-		return xerrors.Wrap(err, "failed to create remote directory")
-	}
-
-	file, err := sftpCli.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
-	if err != nil {
-		return xerrors.Wrap(err, "failed to open remote file")
-	}
-	defer file.Close()
-
-	_, err = file.Write(data)
-	if err != nil {
-		return xerrors.Wrap(err, "failed to write to remote file")
-	}
-
-	return nil
-}
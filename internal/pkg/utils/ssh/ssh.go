@@ -0,0 +1,162 @@
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	xerrors "github.com/pkg/errors"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// 创建 SSH 客户端。
+// 优先使用私钥登录；私钥为空时使用密码登录。
+//
+// 入参:
+//   - host: SSH 主机。零值时默认为 "localhost"。
+//   - port: SSH 端口。零值时默认为 22。
+//   - username: 登录用户名。
+//   - password: 登录密码。
+//   - key: 登录私钥。
+//   - keyPassphrase: 登录私钥口令。
+//
+// 出参:
+//   - client: ssh.Client 对象。
+//   - err: 错误。
+func NewClient(host string, port int32, username string, password string, key string, keyPassphrase string) (client *ssh.Client, err error) {
+	if host == "" {
+		host = "localhost"
+	}
+
+	if port == 0 {
+		port = 22
+	}
+
+	var authMethod ssh.AuthMethod
+	if key != "" {
+		var signer ssh.Signer
+		var err error
+
+		if keyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(keyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(key))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		authMethod = ssh.PublicKeys(signer)
+	} else {
+		authMethod = ssh.Password(password)
+	}
+
+	return ssh.Dial("tcp", fmt.Sprintf("%s:%d", host, port), &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{authMethod},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+}
+
+// 在远程主机上执行命令。
+//
+// 入参:
+//   - sshCli: ssh.Client 对象。
+//   - command: 待执行的命令。
+//
+// 出参:
+//   - stdout: 标准输出。
+//   - stderr: 标准错误输出。
+//   - err: 错误。
+func ExecCommand(sshCli *ssh.Client, command string) (stdout string, stderr string, err error) {
+	session, err := sshCli.NewSession()
+	if err != nil {
+		return "", "", err
+	}
+
+	defer session.Close()
+	var stdoutBuf bytes.Buffer
+	session.Stdout = &stdoutBuf
+	var stderrBuf bytes.Buffer
+	session.Stderr = &stderrBuf
+	err = session.Run(command)
+	if err != nil {
+		return "", "", err
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), nil
+}
+
+// 与 [WriteSftpFile] 类似，但写入的是字符串内容。
+//
+// 入参:
+//   - sshCli: ssh.Client 对象。
+//   - path: 远程文件路径。
+//   - content: 文件内容。
+//
+// 出参:
+//   - 错误。
+func WriteSftpFileString(sshCli *ssh.Client, path string, content string) error {
+	return WriteSftpFile(sshCli, path, []byte(content))
+}
+
+// 通过 SFTP 将数据写入远程主机指定路径的文件。
+// 如果目录不存在，将会递归创建目录。
+// 如果文件不存在，将会创建该文件；如果文件已存在，将会覆盖原有内容。
+//
+// 入参:
+//   - sshCli: ssh.Client 对象。
+//   - path: 远程文件路径。
+//   - data: 文件数据字节数组。
+//
+// 出参:
+//   - 错误。
+func WriteSftpFile(sshCli *ssh.Client, path string, data []byte) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create sftp client")
+	}
+	defer sftpCli.Close()
+
+	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
+		return xerrors.Wrap(err, "failed to create remote directory")
+	}
+
+	file, err := sftpCli.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to open remote file")
+	}
+	defer file.Close()
+
+	_, err = file.Write(data)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to write to remote file")
+	}
+
+	return nil
+}
+
+// 通过 SFTP 删除远程主机指定路径的文件。
+// 如果文件不存在，不会返回错误。
+//
+// 入参:
+//   - sshCli: ssh.Client 对象。
+//   - path: 远程文件路径。
+//
+// 出参:
+//   - 错误。
+func RemoveSftpFile(sshCli *ssh.Client, path string) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create sftp client")
+	}
+	defer sftpCli.Close()
+
+	if err := sftpCli.Remove(path); err != nil && !os.IsNotExist(err) {
+		return xerrors.Wrap(err, "failed to remove remote file")
+	}
+
+	return nil
+}
@@ -1,7 +1,13 @@
 ﻿package x509
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
+	"fmt"
 )
 
 // 比较两个 x509.Certificate 对象，判断它们是否是同一张证书。
@@ -20,3 +26,35 @@ func EqualCertificate(a, b *x509.Certificate) bool {
 		a.Issuer.SerialNumber == b.Issuer.SerialNumber &&
 		a.Subject.SerialNumber == b.Subject.SerialNumber
 }
+
+// 获取证书公钥的算法及长度，格式与申请配置中的密钥算法一致，如 "RSA2048"、"EC256"。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//
+// 出参:
+//   - 公钥算法。无法识别时返回空字符串。
+func GetPublicKeyAlgorithm(cert *x509.Certificate) string {
+	switch pubkey := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA%d", pubkey.N.BitLen())
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("EC%d", pubkey.Curve.Params().BitSize)
+	case ed25519.PublicKey:
+		return "ED25519"
+	default:
+		return ""
+	}
+}
+
+// 计算证书的 SHA-256 指纹。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//
+// 出参:
+//   - 十六进制小写形式的指纹。
+func GetFingerprintSHA256(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -34,6 +34,43 @@ func ParseCertificateFromPEM(certPem string) (cert *x509.Certificate, err error)
 	return cert, nil
 }
 
+// 从 PEM 编码的证书链字符串解析并返回全部 x509.Certificate 对象。
+//
+// 入参:
+//   - certPem: 证书链 PEM 内容。
+//
+// 出参:
+//   - certs: x509.Certificate 对象列表，顺序与 PEM 内容中的顺序一致。
+//   - err: 错误。
+func ParseCertificatesFromPEM(certPem string) (certs []*x509.Certificate, err error) {
+	pemData := []byte(certPem)
+
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to parse certificate")
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("failed to decode PEM block")
+	}
+
+	return certs, nil
+}
+
 // 从 PEM 编码的私钥字符串解析并返回一个 ecdsa.PrivateKey 对象。
 //
 // 入参:
@@ -2,11 +2,16 @@
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"time"
 
+	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/pavlo-v-chernykh/keystore-go/v4"
+	xerrors "github.com/pkg/errors"
 	"software.sslmate.com/src/go-pkcs12"
 )
 
@@ -85,3 +90,128 @@ func TransformCertificateFromPEMToJKS(certPem string, privkeyPem string, jksAlia
 
 	return buf.Bytes(), nil
 }
+
+// 将 PFX 格式的证书数据转换为 PEM 编码的证书链及私钥字符串。
+//
+// 入参:
+//   - pfxData: PFX 格式的证书数据。
+//   - pfxPassword: PFX 密码。
+//
+// 出参:
+//   - certPem: 证书链 PEM 内容。
+//   - privkeyPem: 私钥 PEM 内容。
+//   - err: 错误。
+func TransformCertificateFromPFXToPEM(pfxData []byte, pfxPassword string) (certPem string, privkeyPem string, err error) {
+	privkey, cert, caCerts, err := pkcs12.DecodeChain(pfxData, pfxPassword)
+	if err != nil {
+		return "", "", xerrors.Wrap(err, "failed to decode pfx")
+	}
+
+	privkeyPem, err = encodePrivateKey(privkey)
+	if err != nil {
+		return "", "", err
+	}
+
+	certs := append([]*x509.Certificate{cert}, caCerts...)
+
+	return encodeCertificateChain(certs), privkeyPem, nil
+}
+
+// 将 JKS 格式的证书数据转换为 PEM 编码的证书链及私钥字符串。
+//
+// 入参:
+//   - jksData: JKS 格式的证书数据。
+//   - jksAlias: JKS 别名。为空时使用第一个私钥条目。
+//   - jksKeypass: JKS 密钥密码。
+//   - jksStorepass: JKS 存储密码。
+//
+// 出参:
+//   - certPem: 证书链 PEM 内容。
+//   - privkeyPem: 私钥 PEM 内容。
+//   - err: 错误。
+func TransformCertificateFromJKSToPEM(jksData []byte, jksAlias string, jksKeypass string, jksStorepass string) (certPem string, privkeyPem string, err error) {
+	ks := keystore.New()
+	if err := ks.Load(bytes.NewReader(jksData), []byte(jksStorepass)); err != nil {
+		return "", "", xerrors.Wrap(err, "failed to load jks")
+	}
+
+	if jksAlias == "" {
+		for _, alias := range ks.Aliases() {
+			if ks.IsPrivateKeyEntry(alias) {
+				jksAlias = alias
+				break
+			}
+		}
+	}
+
+	entry, err := ks.GetPrivateKeyEntry(jksAlias, []byte(jksKeypass))
+	if err != nil {
+		return "", "", xerrors.Wrap(err, "failed to get private key entry from jks")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(entry.CertificateChain))
+	for _, c := range entry.CertificateChain {
+		cert, err := x509.ParseCertificate(c.Content)
+		if err != nil {
+			return "", "", xerrors.Wrap(err, "failed to parse certificate")
+		}
+
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return "", "", errors.New("no certificate found in jks")
+	}
+
+	// JKS 中的私钥通常为 PKCS#8 格式，兼容 PKCS#1 及 SEC 1 格式
+	var privkey any
+	if privkey, err = x509.ParsePKCS8PrivateKey(entry.PrivateKey); err != nil {
+		if privkey, err = x509.ParsePKCS1PrivateKey(entry.PrivateKey); err != nil {
+			if privkey, err = x509.ParseECPrivateKey(entry.PrivateKey); err != nil {
+				return "", "", xerrors.Wrap(err, "failed to parse private key")
+			}
+		}
+	}
+
+	privkeyPem, err = encodePrivateKey(privkey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return encodeCertificateChain(certs), privkeyPem, nil
+}
+
+// 将任意格式（PKCS#1、PKCS#8、SEC 1）的 PEM 编码私钥字符串转换为与 lego 签发的私钥一致的格式。
+//
+// 入参:
+//   - privkeyPem: 私钥 PEM 内容。
+//
+// 出参:
+//   - privkeyPem: 转换后的私钥 PEM 内容。
+//   - err: 错误。
+func TransformPrivateKeyFromPEMToLegoPEM(privkeyPem string) (string, error) {
+	privkey, err := certcrypto.ParsePEMPrivateKey([]byte(privkeyPem))
+	if err != nil {
+		return "", xerrors.Wrap(err, "failed to parse private key")
+	}
+
+	return encodePrivateKey(privkey)
+}
+
+// 与 lego 保持一致，RSA 私钥编码为 PKCS#1 格式，ECDSA 私钥编码为 SEC 1 格式。
+func encodePrivateKey(privkey any) (string, error) {
+	switch privkey.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+		return string(certcrypto.PEMEncode(privkey)), nil
+	default:
+		return "", errors.New("unsupported private key type")
+	}
+}
+
+func encodeCertificateChain(certs []*x509.Certificate) string {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,84 @@
+package x509
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func newTestCertificate(t *testing.T) (string, string) {
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return certPem, string(certcrypto.PEMEncode(privkey))
+}
+
+func TestTransformCertificateFromPFXToPEM(t *testing.T) {
+	certPem, privkeyPem := newTestCertificate(t)
+
+	cert, err := ParseCertificateFromPEM(certPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privkey, err := ParseECPrivateKeyFromPEM(privkeyPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pfxData, err := pkcs12.Modern.Encode(privkey, cert, nil, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotCertPem, gotPrivkeyPem, err := TransformCertificateFromPFXToPEM(pfxData, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tls.X509KeyPair([]byte(gotCertPem), []byte(gotPrivkeyPem)); err != nil {
+		t.Errorf("unexpected key pair: %v", err)
+	}
+}
+
+func TestTransformCertificateFromJKSToPEM(t *testing.T) {
+	certPem, privkeyPem := newTestCertificate(t)
+
+	jksData, err := TransformCertificateFromPEMToJKS(certPem, privkeyPem, "alias", "keypass", "storepass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotCertPem, gotPrivkeyPem, err := TransformCertificateFromJKSToPEM(jksData, "", "keypass", "storepass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tls.X509KeyPair([]byte(gotCertPem), []byte(gotPrivkeyPem)); err != nil {
+		t.Errorf("unexpected key pair: %v", err)
+	}
+}
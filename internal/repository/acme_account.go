@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"certimate/internal/domain"
@@ -22,7 +23,7 @@ var g singleflight.Group
 
 func (r *AcmeAccountRepository) GetByCAAndEmail(ca, email string) (*domain.AcmeAccount, error) {
 	resp, err, _ := g.Do(fmt.Sprintf("acme_account_%s_%s", ca, email), func() (interface{}, error) {
-		resp, err := app.GetApp().Dao().FindFirstRecordByFilter("acme_accounts", "ca={:ca} && email={:email}", dbx.Params{"ca": ca, "email": email})
+		resp, err := app.GetApp().Dao().FindFirstRecordByFilter("acme_accounts", "ca={:ca} && email={:email} && status!={:deactivated}", dbx.Params{"ca": ca, "email": email, "deactivated": domain.AcmeAccountStatusDeactivated})
 		if err != nil {
 			return nil, err
 		}
@@ -41,20 +42,48 @@ func (r *AcmeAccountRepository) GetByCAAndEmail(ca, email string) (*domain.AcmeA
 		return nil, fmt.Errorf("acme account not found")
 	}
 
-	resource := &registration.Resource{}
-	if err := record.UnmarshalJSONField("resource", resource); err != nil {
+	return toAcmeAccount(record)
+}
+
+func (r *AcmeAccountRepository) GetById(ctx context.Context, id string) (*domain.AcmeAccount, error) {
+	record, err := app.GetApp().Dao().FindRecordById("acme_accounts", id)
+	if err != nil {
 		return nil, err
 	}
 
-	return &domain.AcmeAccount{
-		Id:       record.GetString("id"),
-		Ca:       record.GetString("ca"),
-		Email:    record.GetString("email"),
-		Key:      record.GetString("key"),
-		Resource: resource,
-		Created:  record.GetTime("created"),
-		Updated:  record.GetTime("updated"),
-	}, nil
+	return toAcmeAccount(record)
+}
+
+func (r *AcmeAccountRepository) List(ctx context.Context) ([]*domain.AcmeAccount, error) {
+	records, err := app.GetApp().Dao().FindRecordsByFilter("acme_accounts", "id!=''", "-created", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*domain.AcmeAccount, 0, len(records))
+	for _, record := range records {
+		account, err := toAcmeAccount(record)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, account)
+	}
+
+	return rs, nil
+}
+
+// 更新已注册账户的联系邮箱、私钥、注册信息及状态。
+func (r *AcmeAccountRepository) Update(ctx context.Context, account *domain.AcmeAccount) error {
+	record, err := app.GetApp().Dao().FindRecordById("acme_accounts", account.Id)
+	if err != nil {
+		return err
+	}
+
+	record.Set("email", account.Email)
+	record.Set("key", account.Key)
+	record.Set("resource", account.Resource)
+	record.Set("status", account.Status)
+	return app.GetApp().Dao().SaveRecord(record)
 }
 
 func (r *AcmeAccountRepository) Save(ca, email, key string, resource *registration.Resource) error {
@@ -68,5 +97,29 @@ func (r *AcmeAccountRepository) Save(ca, email, key string, resource *registrati
 	record.Set("email", email)
 	record.Set("key", key)
 	record.Set("resource", resource)
+	record.Set("status", domain.AcmeAccountStatusValid)
 	return app.GetApp().Dao().Save(record)
 }
+
+func toAcmeAccount(record *models.Record) (*domain.AcmeAccount, error) {
+	resource := &registration.Resource{}
+	if err := record.UnmarshalJSONField("resource", resource); err != nil {
+		return nil, err
+	}
+
+	status := record.GetString("status")
+	if status == "" {
+		status = domain.AcmeAccountStatusValid
+	}
+
+	return &domain.AcmeAccount{
+		Id:       record.GetString("id"),
+		Ca:       record.GetString("ca"),
+		Email:    record.GetString("email"),
+		Status:   status,
+		Key:      record.GetString("key"),
+		Resource: resource,
+		Created:  record.GetTime("created"),
+		Updated:  record.GetTime("updated"),
+	}, nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/models"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+type AcmeServerRepository struct{}
+
+func NewAcmeServerRepository() *AcmeServerRepository {
+	return &AcmeServerRepository{}
+}
+
+func (r *AcmeServerRepository) GetAccountById(ctx context.Context, id string) (*domain.AcmeServerAccount, error) {
+	record, err := app.GetApp().Dao().FindRecordById("acme_server_accounts", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAcmeServerAccount(record), nil
+}
+
+func (r *AcmeServerRepository) GetAccountByThumbprint(ctx context.Context, thumbprint string) (*domain.AcmeServerAccount, error) {
+	record, err := app.GetApp().Dao().FindFirstRecordByFilter("acme_server_accounts", "thumbprint={:thumbprint}", dbx.Params{"thumbprint": thumbprint})
+	if err != nil {
+		return nil, err
+	}
+
+	return toAcmeServerAccount(record), nil
+}
+
+func (r *AcmeServerRepository) SaveAccount(ctx context.Context, account *domain.AcmeServerAccount) error {
+	var record *models.Record
+	if account.Id != "" {
+		var err error
+		record, err = app.GetApp().Dao().FindRecordById("acme_server_accounts", account.Id)
+		if err != nil {
+			return err
+		}
+	} else {
+		collection, err := app.GetApp().Dao().FindCollectionByNameOrId("acme_server_accounts")
+		if err != nil {
+			return err
+		}
+		record = models.NewRecord(collection)
+	}
+
+	record.Set("status", account.Status)
+	record.Set("key", account.Key)
+	record.Set("thumbprint", account.Thumbprint)
+	record.Set("contact", account.Contact)
+	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+		return err
+	}
+
+	account.Id = record.Id
+	account.Created = record.GetTime("created")
+	return nil
+}
+
+func (r *AcmeServerRepository) GetOrderById(ctx context.Context, id string) (*domain.AcmeServerOrder, error) {
+	record, err := app.GetApp().Dao().FindRecordById("acme_server_orders", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAcmeServerOrder(record)
+}
+
+// 查询账户下未过期的订单。
+func (r *AcmeServerRepository) ListOrdersByAccount(ctx context.Context, accountId string) ([]*domain.AcmeServerOrder, error) {
+	records, err := app.GetApp().Dao().FindRecordsByFilter(
+		"acme_server_orders",
+		"account={:account} && expires>{:now}",
+		"created", 0, 0,
+		dbx.Params{"account": accountId, "now": types.NowDateTime().String()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*domain.AcmeServerOrder, 0, len(records))
+	for _, record := range records {
+		order, err := toAcmeServerOrder(record)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, order)
+	}
+
+	return rs, nil
+}
+
+func (r *AcmeServerRepository) ListOrdersByStatus(ctx context.Context, status string) ([]*domain.AcmeServerOrder, error) {
+	records, err := app.GetApp().Dao().FindRecordsByFilter(
+		"acme_server_orders",
+		"status={:status}",
+		"created", 0, 0,
+		dbx.Params{"status": status},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*domain.AcmeServerOrder, 0, len(records))
+	for _, record := range records {
+		order, err := toAcmeServerOrder(record)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, order)
+	}
+
+	return rs, nil
+}
+
+func (r *AcmeServerRepository) SaveOrder(ctx context.Context, order *domain.AcmeServerOrder) error {
+	var record *models.Record
+	if order.Id != "" {
+		var err error
+		record, err = app.GetApp().Dao().FindRecordById("acme_server_orders", order.Id)
+		if err != nil {
+			return err
+		}
+	} else {
+		collection, err := app.GetApp().Dao().FindCollectionByNameOrId("acme_server_orders")
+		if err != nil {
+			return err
+		}
+		record = models.NewRecord(collection)
+	}
+
+	record.Set("account", order.Account)
+	record.Set("status", order.Status)
+	record.Set("identifiers", order.Identifiers)
+	record.Set("csr", order.Csr)
+	record.Set("certificate", order.Certificate)
+	record.Set("error", order.Error)
+	record.Set("expires", order.Expires)
+	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+		return err
+	}
+
+	order.Id = record.Id
+	order.Created = record.GetTime("created")
+	return nil
+}
+
+func toAcmeServerAccount(record *models.Record) *domain.AcmeServerAccount {
+	account := &domain.AcmeServerAccount{
+		Id:         record.Id,
+		Status:     record.GetString("status"),
+		Key:        record.GetString("key"),
+		Thumbprint: record.GetString("thumbprint"),
+		Created:    record.GetTime("created"),
+	}
+	record.UnmarshalJSONField("contact", &account.Contact)
+
+	return account
+}
+
+func toAcmeServerOrder(record *models.Record) (*domain.AcmeServerOrder, error) {
+	order := &domain.AcmeServerOrder{
+		Id:          record.Id,
+		Account:     record.GetString("account"),
+		Status:      record.GetString("status"),
+		Csr:         record.GetString("csr"),
+		Certificate: record.GetString("certificate"),
+		Expires:     record.GetDateTime("expires").Time(),
+		Created:     record.GetTime("created"),
+	}
+	if err := record.UnmarshalJSONField("identifiers", &order.Identifiers); err != nil {
+		return nil, err
+	}
+	if raw := record.GetString("error"); raw != "" && raw != "null" {
+		order.Error = &domain.AcmeServerProblem{}
+		if err := record.UnmarshalJSONField("error", order.Error); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/models"
+)
+
+type InternalCARepository struct{}
+
+func NewInternalCARepository() *InternalCARepository {
+	return &InternalCARepository{}
+}
+
+func (r *InternalCARepository) GetByKind(kind string) (*domain.InternalCA, error) {
+	record, err := app.GetApp().Dao().FindFirstRecordByFilter("internal_ca", "kind={:kind}", dbx.Params{"kind": kind})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.InternalCA{
+		Id:          record.GetString("id"),
+		Kind:        record.GetString("kind"),
+		Certificate: record.GetString("certificate"),
+		PrivateKey:  record.GetString("privateKey"),
+		Created:     record.GetTime("created"),
+		Updated:     record.GetTime("updated"),
+	}, nil
+}
+
+func (r *InternalCARepository) Save(kind, certificate, privateKey string) error {
+	collection, err := app.GetApp().Dao().FindCollectionByNameOrId("internal_ca")
+	if err != nil {
+		return err
+	}
+
+	record := models.NewRecord(collection)
+	record.Set("kind", kind)
+	record.Set("certificate", certificate)
+	record.Set("privateKey", privateKey)
+	return app.GetApp().Dao().Save(record)
+}
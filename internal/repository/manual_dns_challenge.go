@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/app"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/models"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+type ManualDnsChallengeRepository struct{}
+
+func NewManualDnsChallengeRepository() *ManualDnsChallengeRepository {
+	return &ManualDnsChallengeRepository{}
+}
+
+func (r *ManualDnsChallengeRepository) GetById(ctx context.Context, id string) (*domain.ManualDnsChallenge, error) {
+	record, err := app.GetApp().Dao().FindRecordById("manual_dns_challenges", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toManualDnsChallenge(record), nil
+}
+
+// 查询域名下仍在等待确认且未过期的记录。
+func (r *ManualDnsChallengeRepository) ListPendingByDomain(ctx context.Context, domainId string) ([]*domain.ManualDnsChallenge, error) {
+	records, err := app.GetApp().Dao().FindRecordsByFilter(
+		"manual_dns_challenges",
+		"domain={:domain} && status={:status} && expiredAt>{:now}",
+		"created", 0, 0,
+		dbx.Params{"domain": domainId, "status": domain.ManualDnsChallengeStatusPending, "now": types.NowDateTime().String()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*domain.ManualDnsChallenge, 0, len(records))
+	for _, record := range records {
+		rs = append(rs, toManualDnsChallenge(record))
+	}
+
+	return rs, nil
+}
+
+// 查询域名下指定名称及值且未过期的记录，等待中或已确认均可，不存在时返回 nil。
+func (r *ManualDnsChallengeRepository) GetActiveByValue(ctx context.Context, domainId, fqdn, value string) (*domain.ManualDnsChallenge, error) {
+	records, err := app.GetApp().Dao().FindRecordsByFilter(
+		"manual_dns_challenges",
+		"domain={:domain} && fqdn={:fqdn} && value={:value} && (status={:pending} || status={:confirmed}) && expiredAt>{:now}",
+		"-created", 1, 0,
+		dbx.Params{
+			"domain":    domainId,
+			"fqdn":      fqdn,
+			"value":     value,
+			"pending":   domain.ManualDnsChallengeStatusPending,
+			"confirmed": domain.ManualDnsChallengeStatusConfirmed,
+			"now":       types.NowDateTime().String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	return toManualDnsChallenge(records[0]), nil
+}
+
+// 查询仍有记录在等待确认的域名。
+func (r *ManualDnsChallengeRepository) ListPendingDomainIds(ctx context.Context) ([]string, error) {
+	var rs []string
+	err := app.GetApp().Dao().DB().
+		Select("domain").
+		Distinct(true).
+		From("manual_dns_challenges").
+		Where(dbx.HashExp{"status": domain.ManualDnsChallengeStatusPending}).
+		Column(&rs)
+	return rs, err
+}
+
+func (r *ManualDnsChallengeRepository) Save(ctx context.Context, challenge *domain.ManualDnsChallenge) error {
+	collection, err := app.GetApp().Dao().FindCollectionByNameOrId("manual_dns_challenges")
+	if err != nil {
+		return err
+	}
+
+	record := models.NewRecord(collection)
+	record.Set("domain", challenge.DomainId)
+	record.Set("identifier", challenge.Identifier)
+	record.Set("fqdn", challenge.Fqdn)
+	record.Set("value", challenge.Value)
+	record.Set("status", challenge.Status)
+	record.Set("expiredAt", challenge.ExpiredAt)
+	if err := app.GetApp().Dao().SaveRecord(record); err != nil {
+		return err
+	}
+
+	challenge.Id = record.Id
+	return nil
+}
+
+func (r *ManualDnsChallengeRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+	record, err := app.GetApp().Dao().FindRecordById("manual_dns_challenges", id)
+	if err != nil {
+		return err
+	}
+
+	record.Set("status", status)
+	return app.GetApp().Dao().SaveRecord(record)
+}
+
+// 将域名下等待确认的记录标记为已过期，用于申请结束后清理不再使用的记录。
+func (r *ManualDnsChallengeRepository) ExpirePendingByDomain(ctx context.Context, domainId string) error {
+	_, err := app.GetApp().Dao().DB().
+		Update("manual_dns_challenges",
+			dbx.Params{"status": domain.ManualDnsChallengeStatusExpired, "updated": types.NowDateTime().String()},
+			dbx.HashExp{"domain": domainId, "status": domain.ManualDnsChallengeStatusPending}).
+		Execute()
+	return err
+}
+
+// 将超过等待时间仍未确认的记录标记为已过期。
+func (r *ManualDnsChallengeRepository) ExpireOverdue(ctx context.Context) error {
+	_, err := app.GetApp().Dao().DB().
+		Update("manual_dns_challenges",
+			dbx.Params{"status": domain.ManualDnsChallengeStatusExpired, "updated": types.NowDateTime().String()},
+			dbx.And(
+				dbx.HashExp{"status": domain.ManualDnsChallengeStatusPending},
+				dbx.NewExp("expiredAt<={:now}", dbx.Params{"now": types.NowDateTime().String()}),
+			)).
+		Execute()
+	return err
+}
+
+func toManualDnsChallenge(record *models.Record) *domain.ManualDnsChallenge {
+	return &domain.ManualDnsChallenge{
+		Id:         record.Id,
+		DomainId:   record.GetString("domain"),
+		Identifier: record.GetString("identifier"),
+		Fqdn:       record.GetString("fqdn"),
+		Value:      record.GetString("value"),
+		Status:     record.GetString("status"),
+		ExpiredAt:  record.GetDateTime("expiredAt").Time(),
+		Created:    record.GetTime("created"),
+		Updated:    record.GetTime("updated"),
+	}
+}
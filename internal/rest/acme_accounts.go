@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"context"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/resp"
+
+	"github.com/labstack/echo/v5"
+)
+
+type AcmeAccountService interface {
+	List(ctx context.Context) ([]*domain.AcmeAccountResp, error)
+	KeyChange(ctx context.Context, req *domain.AcmeAccountKeyChangeReq) error
+	UpdateContact(ctx context.Context, req *domain.AcmeAccountUpdateContactReq) error
+	Deactivate(ctx context.Context, req *domain.AcmeAccountDeactivateReq) error
+}
+
+type acmeAccountHandler struct {
+	service AcmeAccountService
+}
+
+func NewAcmeAccountHandler(route *echo.Group, service AcmeAccountService) {
+	handler := &acmeAccountHandler{
+		service: service,
+	}
+
+	group := route.Group("/acme-accounts")
+
+	group.GET("", handler.list)
+	group.POST("/:id/key-change", handler.keyChange)
+	group.POST("/:id/contact", handler.updateContact)
+	group.POST("/:id/deactivate", handler.deactivate)
+}
+
+func (handler *acmeAccountHandler) list(c echo.Context) error {
+	rs, err := handler.service.List(c.Request().Context())
+	if err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, rs)
+}
+
+func (handler *acmeAccountHandler) keyChange(c echo.Context) error {
+	req := &domain.AcmeAccountKeyChangeReq{
+		Id: c.PathParam("id"),
+	}
+
+	if err := handler.service.KeyChange(c.Request().Context(), req); err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, nil)
+}
+
+func (handler *acmeAccountHandler) updateContact(c echo.Context) error {
+	req := &domain.AcmeAccountUpdateContactReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	req.Id = c.PathParam("id")
+
+	if err := handler.service.UpdateContact(c.Request().Context(), req); err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, nil)
+}
+
+func (handler *acmeAccountHandler) deactivate(c echo.Context) error {
+	req := &domain.AcmeAccountDeactivateReq{
+		Id: c.PathParam("id"),
+	}
+
+	if err := handler.service.Deactivate(c.Request().Context(), req); err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, nil)
+}
@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+)
+
+type AcmeChallengeService interface {
+	GetKeyAuthorization(ctx context.Context, token string) (string, error)
+}
+
+type acmeChallengeHandler struct {
+	service AcmeChallengeService
+}
+
+func NewAcmeChallengeHandler(route *echo.Group, service AcmeChallengeService) {
+	handler := &acmeChallengeHandler{
+		service: service,
+	}
+
+	group := route.Group("/.well-known/acme-challenge")
+
+	group.GET("/:token", handler.get)
+}
+
+func (handler *acmeChallengeHandler) get(c echo.Context) error {
+	keyAuth, err := handler.service.GetKeyAuthorization(c.Request().Context(), c.PathParam("token"))
+	if err != nil {
+		return c.String(http.StatusNotFound, err.Error())
+	}
+
+	return c.String(http.StatusOK, keyAuth)
+}
@@ -0,0 +1,343 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"certimate/internal/domain"
+
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	acmeServerPath = "/acme"
+
+	acmeServerOrderRetryAfter = 5
+)
+
+type AcmeServerService interface {
+	GetSetting(ctx context.Context) (*domain.AcmeServerSetting, error)
+	NewNonce() string
+	Verify(ctx context.Context, body []byte, url string) (*domain.AcmeServerRequest, error)
+	NewAccount(ctx context.Context, req *domain.AcmeServerRequest) (*domain.AcmeServerAccount, bool, error)
+	UpdateAccount(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerAccount, error)
+	NewOrder(ctx context.Context, req *domain.AcmeServerRequest) (*domain.AcmeServerOrder, error)
+	GetOrder(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, error)
+	ListOrders(ctx context.Context, req *domain.AcmeServerRequest, accountId string) ([]*domain.AcmeServerOrder, error)
+	GetAuthorization(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, int, error)
+	Finalize(ctx context.Context, req *domain.AcmeServerRequest, id string) (*domain.AcmeServerOrder, error)
+	GetCertificate(ctx context.Context, req *domain.AcmeServerRequest, id string) (string, error)
+}
+
+type acmeServerHandler struct {
+	service AcmeServerService
+}
+
+func NewAcmeServerHandler(route *echo.Group, service AcmeServerService) {
+	handler := &acmeServerHandler{
+		service: service,
+	}
+
+	group := route.Group(acmeServerPath, handler.middleware)
+
+	group.GET("/directory", handler.directory)
+	group.HEAD("/new-nonce", handler.newNonce)
+	group.GET("/new-nonce", handler.newNonce)
+	group.POST("/new-account", handler.newAccount)
+	group.POST("/account/:id", handler.account)
+	group.POST("/account/:id/orders", handler.orders)
+	group.POST("/new-order", handler.newOrder)
+	group.POST("/order/:id", handler.order)
+	group.POST("/order/:id/finalize", handler.finalize)
+	group.POST("/authz/:id", handler.authorization)
+	group.POST("/chall/:id", handler.challenge)
+	group.POST("/cert/:id", handler.certificate)
+	group.POST("/revoke-cert", handler.unsupported)
+	group.POST("/key-change", handler.unsupported)
+}
+
+// 未启用或未配置 EAB 时隐藏 ACME 服务，启用时为每个响应附加新的防重放随机数。
+func (handler *acmeServerHandler) middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		setting, err := handler.service.GetSetting(c.Request().Context())
+		if err != nil {
+			return handler.problem(c, err)
+		}
+
+		if !setting.Enabled {
+			return handler.problem(c, domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "acme server is disabled"))
+		}
+
+		if !setting.HasExternalAccountBinding() {
+			return handler.problem(c, domain.NewAcmeServerProblem(http.StatusNotFound, "malformed", "acme server requires external account binding to be configured"))
+		}
+
+		c.Response().Header().Set("Replay-Nonce", handler.service.NewNonce())
+		c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+		return next(c)
+	}
+}
+
+func (handler *acmeServerHandler) directory(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]any{
+		"newNonce":   handler.url(c, "/new-nonce"),
+		"newAccount": handler.url(c, "/new-account"),
+		"newOrder":   handler.url(c, "/new-order"),
+		"revokeCert": handler.url(c, "/revoke-cert"),
+		"keyChange":  handler.url(c, "/key-change"),
+		"meta": map[string]any{
+			"externalAccountRequired": true,
+		},
+	})
+}
+
+func (handler *acmeServerHandler) newNonce(c echo.Context) error {
+	if c.Request().Method == http.MethodHead {
+		return c.NoContent(http.StatusOK)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (handler *acmeServerHandler) newAccount(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	account, created, err := handler.service.NewAccount(c.Request().Context(), req)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, handler.url(c, "/account/"+account.Id))
+	return c.JSON(status, handler.accountObject(c, account))
+}
+
+func (handler *acmeServerHandler) account(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	account, err := handler.service.UpdateAccount(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	return c.JSON(http.StatusOK, handler.accountObject(c, account))
+}
+
+func (handler *acmeServerHandler) orders(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	orders, err := handler.service.ListOrders(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	urls := make([]string, len(orders))
+	for i, order := range orders {
+		urls[i] = handler.url(c, "/order/"+order.Id)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"orders": urls,
+	})
+}
+
+func (handler *acmeServerHandler) newOrder(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	order, err := handler.service.NewOrder(c.Request().Context(), req)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, handler.url(c, "/order/"+order.Id))
+	return c.JSON(http.StatusCreated, handler.orderObject(c, order))
+}
+
+func (handler *acmeServerHandler) order(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	order, err := handler.service.GetOrder(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	if order.Status == domain.AcmeServerStatusProcessing {
+		c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(acmeServerOrderRetryAfter))
+	}
+
+	return c.JSON(http.StatusOK, handler.orderObject(c, order))
+}
+
+func (handler *acmeServerHandler) finalize(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	order, err := handler.service.Finalize(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, handler.url(c, "/order/"+order.Id))
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(acmeServerOrderRetryAfter))
+	return c.JSON(http.StatusOK, handler.orderObject(c, order))
+}
+
+func (handler *acmeServerHandler) authorization(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	order, idx, err := handler.service.GetAuthorization(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	return c.JSON(http.StatusOK, handler.authorizationObject(c, order, idx))
+}
+
+func (handler *acmeServerHandler) challenge(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	order, idx, err := handler.service.GetAuthorization(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	authzId := order.Id + "-" + strconv.Itoa(idx)
+	c.Response().Header().Add("Link", "<"+handler.url(c, "/authz/"+authzId)+`>;rel="up"`)
+	return c.JSON(http.StatusOK, handler.challengeObject(c, order, idx))
+}
+
+func (handler *acmeServerHandler) certificate(c echo.Context) error {
+	req, err := handler.verify(c)
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	certificate, err := handler.service.GetCertificate(c.Request().Context(), req, c.PathParam("id"))
+	if err != nil {
+		return handler.problem(c, err)
+	}
+
+	return c.Blob(http.StatusOK, "application/pem-certificate-chain", []byte(certificate))
+}
+
+func (handler *acmeServerHandler) unsupported(c echo.Context) error {
+	return handler.problem(c, domain.NewAcmeServerProblem(http.StatusForbidden, "unauthorized", "not supported by certimate, please revoke the certificate in certimate"))
+}
+
+func (handler *acmeServerHandler) verify(c echo.Context) (*domain.AcmeServerRequest, error) {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.service.Verify(c.Request().Context(), body, handler.url(c, strings.TrimPrefix(c.Request().URL.Path, acmeServerPath)))
+}
+
+func (handler *acmeServerHandler) problem(c echo.Context, err error) error {
+	problem := domain.ToAcmeServerProblem(err)
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/problem+json")
+	return c.JSON(problem.Status, problem)
+}
+
+func (handler *acmeServerHandler) url(c echo.Context, path string) string {
+	return c.Scheme() + "://" + c.Request().Host + acmeServerPath + path
+}
+
+func (handler *acmeServerHandler) accountObject(c echo.Context, account *domain.AcmeServerAccount) map[string]any {
+	contact := account.Contact
+	if contact == nil {
+		contact = []string{}
+	}
+
+	return map[string]any{
+		"status":  account.Status,
+		"contact": contact,
+		"orders":  handler.url(c, "/account/"+account.Id+"/orders"),
+	}
+}
+
+func (handler *acmeServerHandler) orderObject(c echo.Context, order *domain.AcmeServerOrder) map[string]any {
+	authorizations := make([]string, len(order.Identifiers))
+	for i := range order.Identifiers {
+		authorizations[i] = handler.url(c, "/authz/"+order.Id+"-"+strconv.Itoa(i))
+	}
+
+	rs := map[string]any{
+		"status":         order.Status,
+		"expires":        order.Expires.UTC().Format(time.RFC3339),
+		"identifiers":    order.Identifiers,
+		"authorizations": authorizations,
+		"finalize":       handler.url(c, "/order/"+order.Id+"/finalize"),
+	}
+	if order.Status == domain.AcmeServerStatusValid {
+		rs["certificate"] = handler.url(c, "/cert/"+order.Id)
+	}
+	if order.Error != nil {
+		rs["error"] = order.Error
+	}
+
+	return rs
+}
+
+// 授权在创建订单时已根据允许列表验证，始终为有效状态。
+func (handler *acmeServerHandler) authorizationObject(c echo.Context, order *domain.AcmeServerOrder, idx int) map[string]any {
+	identifier := order.Identifiers[idx]
+
+	rs := map[string]any{
+		"status":     domain.AcmeServerStatusValid,
+		"expires":    order.Expires.UTC().Format(time.RFC3339),
+		"challenges": []map[string]any{handler.challengeObject(c, order, idx)},
+	}
+	if value, ok := strings.CutPrefix(identifier.Value, "*."); ok {
+		rs["identifier"] = domain.AcmeServerIdentifier{Type: identifier.Type, Value: value}
+		rs["wildcard"] = true
+	} else {
+		rs["identifier"] = identifier
+	}
+
+	return rs
+}
+
+func (handler *acmeServerHandler) challengeObject(c echo.Context, order *domain.AcmeServerOrder, idx int) map[string]any {
+	id := order.Id + "-" + strconv.Itoa(idx)
+
+	return map[string]any{
+		"type":      "dns-01",
+		"url":       handler.url(c, "/chall/"+id),
+		"status":    domain.AcmeServerStatusValid,
+		"token":     id,
+		"validated": order.Created.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/resp"
+
+	"github.com/labstack/echo/v5"
+)
+
+type DomainService interface {
+	Revoke(ctx context.Context, req *domain.DomainRevokeReq) error
+	Import(ctx context.Context, req *domain.DomainImportReq) (*domain.DomainImportResp, error)
+	Preflight(ctx context.Context, req *domain.DomainPreflightReq) (*domain.DomainPreflightReport, error)
+}
+
+type domainHandler struct {
+	service DomainService
+}
+
+func NewDomainHandler(route *echo.Group, service DomainService) {
+	handler := &domainHandler{
+		service: service,
+	}
+
+	group := route.Group("/domains")
+
+	group.POST("/import", handler.importCertificate)
+	group.POST("/:id/revoke", handler.revoke)
+	group.POST("/:id/preflight", handler.preflight)
+}
+
+func (handler *domainHandler) revoke(c echo.Context) error {
+	req := &domain.DomainRevokeReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	req.DomainId = c.PathParam("id")
+
+	if err := handler.service.Revoke(c.Request().Context(), req); err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, nil)
+}
+
+func (handler *domainHandler) importCertificate(c echo.Context) error {
+	req := &domain.DomainImportReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	rs, err := handler.service.Import(c.Request().Context(), req)
+	if err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, rs)
+}
+
+func (handler *domainHandler) preflight(c echo.Context) error {
+	req := &domain.DomainPreflightReq{
+		DomainId: c.PathParam("id"),
+	}
+
+	rs, err := handler.service.Preflight(c.Request().Context(), req)
+	if err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, rs)
+}
@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/resp"
+
+	"github.com/labstack/echo/v5"
+)
+
+type InternalCAService interface {
+	GetRootCertificate(ctx context.Context) (string, error)
+}
+
+type internalCAHandler struct {
+	service InternalCAService
+}
+
+func NewInternalCAHandler(route *echo.Group, service InternalCAService) {
+	handler := &internalCAHandler{
+		service: service,
+	}
+
+	group := route.Group("/internal-ca")
+
+	group.GET("/root.crt", handler.downloadRoot)
+}
+
+func (handler *internalCAHandler) downloadRoot(c echo.Context) error {
+	rootPem, err := handler.service.GetRootCertificate(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrInternalCANotFound) {
+			return c.String(http.StatusNotFound, err.Error())
+		}
+		return resp.Err(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="certimate-root-ca.crt"`)
+	return c.Blob(http.StatusOK, "application/x-pem-file", []byte(rootPem))
+}
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"context"
+
+	"certimate/internal/domain"
+	"certimate/internal/utils/resp"
+
+	"github.com/labstack/echo/v5"
+)
+
+type ManualDnsService interface {
+	List(ctx context.Context, req *domain.ManualDnsChallengeListReq) ([]*domain.ManualDnsChallenge, error)
+	Confirm(ctx context.Context, req *domain.ManualDnsChallengeConfirmReq) error
+}
+
+type manualDnsHandler struct {
+	service ManualDnsService
+}
+
+func NewManualDnsHandler(route *echo.Group, service ManualDnsService) {
+	handler := &manualDnsHandler{
+		service: service,
+	}
+
+	group := route.Group("/domains/:id/manual-dns")
+
+	group.GET("", handler.list)
+	group.POST("/confirm", handler.confirm)
+}
+
+func (handler *manualDnsHandler) list(c echo.Context) error {
+	req := &domain.ManualDnsChallengeListReq{
+		DomainId: c.PathParam("id"),
+	}
+
+	rs, err := handler.service.List(c.Request().Context(), req)
+	if err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, rs)
+}
+
+func (handler *manualDnsHandler) confirm(c echo.Context) error {
+	req := &domain.ManualDnsChallengeConfirmReq{
+		DomainId: c.PathParam("id"),
+	}
+
+	if err := handler.service.Confirm(c.Request().Context(), req); err != nil {
+		return resp.Err(c, err)
+	}
+
+	return resp.Succ(c, nil)
+}
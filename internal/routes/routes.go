@@ -1,9 +1,16 @@
 package routes
 
 import (
+	"context"
+
+	"certimate/internal/acmeserver"
+	"certimate/internal/applicant"
+	"certimate/internal/ca"
+	"certimate/internal/domains"
 	"certimate/internal/notify"
 	"certimate/internal/repository"
 	"certimate/internal/rest"
+	"certimate/internal/utils/app"
 
 	"github.com/labstack/echo/v5"
 	"github.com/pocketbase/pocketbase/apis"
@@ -13,7 +20,35 @@ func Register(e *echo.Echo) {
 	notifyRepo := repository.NewSettingRepository()
 	notifySvc := notify.NewNotifyService(notifyRepo)
 
+	domainSvc := domains.NewDomainService()
+
+	httpChallengeSvc := applicant.NewHttpChallengeService()
+
+	acmeAccountSvc := applicant.NewAcmeAccountService()
+
+	manualDnsSvc := applicant.NewManualDnsService()
+
+	internalCASvc := ca.NewInternalCAService()
+
+	acmeServerSvc := acmeserver.NewAcmeServerService(repository.NewAcmeServerRepository(), repository.NewSettingRepository())
+	// 重启前仍在签发中的订单重新入队，避免客户端一直轮询到订单过期
+	if err := acmeServerSvc.ResumeProcessing(context.Background()); err != nil {
+		app.GetApp().Logger().Error("恢复 ACME 服务订单失败", "err", err)
+	}
+
 	group := e.Group("/api", apis.RequireAdminAuth())
 
 	rest.NewNotifyHandler(group, notifySvc)
+	rest.NewDomainHandler(group, domainSvc)
+	rest.NewAcmeAccountHandler(group, acmeAccountSvc)
+	rest.NewManualDnsHandler(group, manualDnsSvc)
+
+	// ACME HTTP-01 质询需要被 CA 匿名访问，不能挂在需要鉴权的 /api 下
+	rest.NewAcmeChallengeHandler(e.Group(""), httpChallengeSvc)
+
+	// 内置 CA 的根证书需要被客户端匿名下载以加入信任
+	rest.NewInternalCAHandler(e.Group("/api"), internalCASvc)
+
+	// 内置 ACME 服务由客户端通过 JWS 签名鉴权，不能挂在需要管理员鉴权的 /api 下
+	rest.NewAcmeServerHandler(e.Group(""), acmeServerSvc)
 }
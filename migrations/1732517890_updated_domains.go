@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_ca := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "0y1zd33r",
+			"name": "ca",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_ca); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_ca)
+
+		return dao.SaveCollection(collection)
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		collection.Schema.RemoveField("0y1zd33r")
+
+		return dao.SaveCollection(collection)
+	})
+}
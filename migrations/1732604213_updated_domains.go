@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_renewalWindowStart := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "hsubmi1z",
+			"name": "renewalWindowStart",
+			"type": "date",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": "",
+				"max": ""
+			}
+		}`), new_renewalWindowStart); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_renewalWindowStart)
+
+		// add
+		new_renewalWindowEnd := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "c2tpk6nu",
+			"name": "renewalWindowEnd",
+			"type": "date",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": "",
+				"max": ""
+			}
+		}`), new_renewalWindowEnd); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_renewalWindowEnd)
+
+		return dao.SaveCollection(collection)
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		collection.Schema.RemoveField("hsubmi1z")
+
+		// remove
+		collection.Schema.RemoveField("c2tpk6nu")
+
+		return dao.SaveCollection(collection)
+	})
+}
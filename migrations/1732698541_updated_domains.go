@@ -0,0 +1,197 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+
+	"certimate/internal/domain"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_issuedAt := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "n2s9f6bm",
+			"name": "issuedAt",
+			"type": "date",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": "",
+				"max": ""
+			}
+		}`), new_issuedAt); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_issuedAt)
+
+		// add
+		new_serialNumber := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "xw0so6bb",
+			"name": "serialNumber",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_serialNumber); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_serialNumber)
+
+		// add
+		new_issuer := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "fifvkcj4",
+			"name": "issuer",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_issuer); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_issuer)
+
+		// add
+		new_sans := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "360x52gz",
+			"name": "sans",
+			"type": "json",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"maxSize": 2000000
+			}
+		}`), new_sans); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_sans)
+
+		// add
+		new_keyAlgorithm := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "lmi01r86",
+			"name": "keyAlgorithm",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_keyAlgorithm); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_keyAlgorithm)
+
+		// add
+		new_fingerprint := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "ury01s71",
+			"name": "fingerprint",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_fingerprint); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_fingerprint)
+
+		if err := dao.SaveCollection(collection); err != nil {
+			return err
+		}
+
+		// 根据已保存的证书回填真实的有效期及元数据
+		records, err := dao.FindRecordsByFilter("domains", "certificate!=''", "", 0, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			meta, err := domain.ParseCertificateMeta(record.GetString("certificate"))
+			if err != nil {
+				continue
+			}
+
+			record.Set("expiredAt", meta.ExpiredAt)
+			record.Set("issuedAt", meta.IssuedAt)
+			record.Set("serialNumber", meta.SerialNumber)
+			record.Set("issuer", meta.Issuer)
+			record.Set("sans", meta.SANs)
+			record.Set("keyAlgorithm", meta.KeyAlgorithm)
+			record.Set("fingerprint", meta.Fingerprint)
+			if err := dao.SaveRecord(record); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		collection.Schema.RemoveField("n2s9f6bm")
+
+		// remove
+		collection.Schema.RemoveField("xw0so6bb")
+
+		// remove
+		collection.Schema.RemoveField("fifvkcj4")
+
+		// remove
+		collection.Schema.RemoveField("360x52gz")
+
+		// remove
+		collection.Schema.RemoveField("lmi01r86")
+
+		// remove
+		collection.Schema.RemoveField("ury01s71")
+
+		return dao.SaveCollection(collection)
+	})
+}
@@ -0,0 +1,160 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("0a1o4e6sstp694f")
+		if err != nil {
+			return err
+		}
+
+		// update
+		edit_phase := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "qbxdtg9q",
+			"name": "phase",
+			"type": "select",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"maxSelect": 1,
+				"values": [
+					"check",
+					"apply",
+					"deploy",
+					"revoke"
+				]
+			}
+		}`), edit_phase); err != nil {
+			return err
+		}
+		collection.Schema.AddField(edit_phase)
+
+		// add
+		new_certificate := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "4kd9xw2m",
+			"name": "certificate",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_certificate); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_certificate)
+
+		// add
+		new_ca := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "r7tq0v5e",
+			"name": "ca",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_ca); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_ca)
+
+		if err := dao.SaveCollection(collection); err != nil {
+			return err
+		}
+
+		domains, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_revoked := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "m3ybz8kq",
+			"name": "revoked",
+			"type": "bool",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {}
+		}`), new_revoked); err != nil {
+			return err
+		}
+		domains.Schema.AddField(new_revoked)
+
+		return dao.SaveCollection(domains)
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("0a1o4e6sstp694f")
+		if err != nil {
+			return err
+		}
+
+		// update
+		edit_phase := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "qbxdtg9q",
+			"name": "phase",
+			"type": "select",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"maxSelect": 1,
+				"values": [
+					"check",
+					"apply",
+					"deploy"
+				]
+			}
+		}`), edit_phase); err != nil {
+			return err
+		}
+		collection.Schema.AddField(edit_phase)
+
+		// remove
+		collection.Schema.RemoveField("4kd9xw2m")
+		collection.Schema.RemoveField("r7tq0v5e")
+
+		if err := dao.SaveCollection(collection); err != nil {
+			return err
+		}
+
+		domains, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		domains.Schema.RemoveField("m3ybz8kq")
+
+		return dao.SaveCollection(domains)
+	})
+}
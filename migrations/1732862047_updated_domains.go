@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+
+	"certimate/internal/domain"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_chainRoot := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "h5cw1rnd",
+			"name": "chainRoot",
+			"type": "text",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"min": null,
+				"max": null,
+				"pattern": ""
+			}
+		}`), new_chainRoot); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_chainRoot)
+
+		if err := dao.SaveCollection(collection); err != nil {
+			return err
+		}
+
+		// 回填已签发证书的证书链根证书
+		records, err := dao.FindRecordsByFilter("domains", "certificate!=''", "", 0, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			meta, err := domain.ParseCertificateMeta(record.GetString("certificate"))
+			if err != nil {
+				continue
+			}
+
+			record.Set("chainRoot", meta.ChainRoot)
+			if err := dao.SaveRecord(record); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("z3p974ainxjqlvs")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		collection.Schema.RemoveField("h5cw1rnd")
+
+		return dao.SaveCollection(collection)
+	})
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/daos"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/models/schema"
+)
+
+func init() {
+	m.Register(func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("012d7abbod1hwvr")
+		if err != nil {
+			return err
+		}
+
+		// add
+		new_status := &schema.SchemaField{}
+		if err := json.Unmarshal([]byte(`{
+			"system": false,
+			"id": "h3u7kc2z",
+			"name": "status",
+			"type": "select",
+			"required": false,
+			"presentable": false,
+			"unique": false,
+			"options": {
+				"maxSelect": 1,
+				"values": [
+					"valid",
+					"deactivated"
+				]
+			}
+		}`), new_status); err != nil {
+			return err
+		}
+		collection.Schema.AddField(new_status)
+
+		// 已有账户的状态为空，视为有效
+		return dao.SaveCollection(collection)
+	}, func(db dbx.Builder) error {
+		dao := daos.New(db)
+
+		collection, err := dao.FindCollectionByNameOrId("012d7abbod1hwvr")
+		if err != nil {
+			return err
+		}
+
+		// remove
+		collection.Schema.RemoveField("h3u7kc2z")
+
+		return dao.SaveCollection(collection)
+	})
+}
@@ -0,0 +1,47 @@
+import { getPb } from "@/repository/api";
+
+export type AcmeAccount = {
+  id: string;
+  ca: string;
+  email: string;
+  status: "valid" | "deactivated";
+  uri: string;
+  created: string;
+  updated: string;
+};
+
+const send = async (path: string, method: string, body?: Record<string, unknown>) => {
+  const pb = getPb();
+
+  const resp = await pb.send(path, {
+    method,
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body,
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp;
+};
+
+export const list = async () => {
+  const resp = await send("/api/acme-accounts", "GET");
+
+  return resp.data as AcmeAccount[];
+};
+
+export const keyChange = async (id: string) => {
+  return send(`/api/acme-accounts/${encodeURIComponent(id)}/key-change`, "POST");
+};
+
+export const updateContact = async (id: string, email: string) => {
+  return send(`/api/acme-accounts/${encodeURIComponent(id)}/contact`, "POST", { email });
+};
+
+export const deactivate = async (id: string) => {
+  return send(`/api/acme-accounts/${encodeURIComponent(id)}/deactivate`, "POST");
+};
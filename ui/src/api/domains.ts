@@ -0,0 +1,124 @@
+import { getPb } from "@/repository/api";
+
+export const revoke = async (id: string, reason: number, reissue: boolean, deploymentId?: string) => {
+  const pb = getPb();
+
+  const resp = await pb.send(`/api/domains/${encodeURIComponent(id)}/revoke`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: {
+      deploymentId,
+      reason,
+      reissue,
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp;
+};
+
+export type ImportCertificateReq = {
+  domainId?: string;
+  format: "pem" | "pfx" | "jks";
+  certificate?: string;
+  privateKey?: string;
+  data?: string;
+  password?: string;
+  jksAlias?: string;
+  jksKeypass?: string;
+  deploy?: boolean;
+};
+
+export const importCertificate = async (req: ImportCertificateReq) => {
+  const pb = getPb();
+
+  const resp = await pb.send("/api/domains/import", {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: req,
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp;
+};
+
+export type ManualDnsChallenge = {
+  id: string;
+  domainId: string;
+  identifier: string;
+  fqdn: string;
+  value: string;
+  status: "pending" | "confirmed" | "expired";
+  expiredAt: string;
+};
+
+export const listManualDnsChallenges = async (id: string) => {
+  const pb = getPb();
+
+  const resp = await pb.send(`/api/domains/${encodeURIComponent(id)}/manual-dns`, {
+    method: "GET",
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp.data as ManualDnsChallenge[];
+};
+
+export const confirmManualDnsChallenges = async (id: string) => {
+  const pb = getPb();
+
+  const resp = await pb.send(`/api/domains/${encodeURIComponent(id)}/manual-dns/confirm`, {
+    method: "POST",
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp;
+};
+
+export type PreflightCheck = {
+  type: "resolve" | "caa" | "cname" | "txt";
+  status: "pass" | "warn" | "fail" | "skip";
+  message: string;
+  records?: string[];
+};
+
+export type PreflightReport = {
+  domain: string;
+  challengeType: string;
+  sslProvider: string;
+  nameservers: string[];
+  passed: boolean;
+  names: {
+    name: string;
+    checks: PreflightCheck[];
+  }[];
+};
+
+export const preflight = async (id: string) => {
+  const pb = getPb();
+
+  const resp = await pb.send(`/api/domains/${encodeURIComponent(id)}/preflight`, {
+    method: "POST",
+  });
+
+  if (resp.code != 0) {
+    throw new Error(resp.msg);
+  }
+
+  return resp.data as PreflightReport;
+};
@@ -0,0 +1,7 @@
+import { getPb } from "@/repository/api";
+
+export const getInternalCARootUrl = () => {
+  const pb = getPb();
+
+  return pb.buildUrl("/api/internal-ca/root.crt");
+};
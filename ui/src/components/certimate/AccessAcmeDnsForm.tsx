@@ -0,0 +1,242 @@
+import { useForm } from "react-hook-form";
+import { useTranslation } from "react-i18next";
+import z from "zod";
+import { zodResolver } from "@hookform/resolvers/zod";
+import { ClientResponseError } from "pocketbase";
+
+import { Button } from "@/components/ui/button";
+import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { Textarea } from "@/components/ui/textarea";
+import { PbErrorData } from "@/domain/base";
+import { accessProvidersMap, accessTypeFormSchema, type Access, type AcmeDnsConfig } from "@/domain/access";
+import { save } from "@/repository/access";
+import { useConfigContext } from "@/providers/config";
+
+// 每行一个 CIDR 网段
+const parseAllowFrom = (text: string) => {
+  return text
+    .split("\n")
+    .map((line) => line.trim())
+    .filter((line) => !!line);
+};
+
+type AccessAcmeDnsFormProps = {
+  op: "add" | "edit" | "copy";
+  data?: Access;
+  onAfterReq: () => void;
+};
+
+const AccessAcmeDnsForm = ({ data, op, onAfterReq }: AccessAcmeDnsFormProps) => {
+  const { addAccess, updateAccess } = useConfigContext();
+  const { t } = useTranslation();
+  const formSchema = z.object({
+    id: z.string().optional(),
+    name: z
+      .string()
+      .min(1, "access.authorization.form.name.placeholder")
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    configType: accessTypeFormSchema,
+    apiBase: z.string().url("common.errmsg.url_invalid"),
+    allowFrom: z.string(),
+    autoRegister: z.boolean(),
+  });
+
+  let config: AcmeDnsConfig = {
+    apiBase: "",
+    allowFrom: [],
+    autoRegister: false,
+    registrations: {},
+  };
+  if (data) config = data.config as AcmeDnsConfig;
+
+  const form = useForm<z.infer<typeof formSchema>>({
+    resolver: zodResolver(formSchema),
+    defaultValues: {
+      id: data?.id,
+      name: data?.name || "",
+      configType: "acmedns",
+      apiBase: config.apiBase,
+      allowFrom: (config.allowFrom ?? []).join("\n"),
+      autoRegister: config.autoRegister,
+    },
+  });
+
+  const onSubmit = async (data: z.infer<typeof formSchema>) => {
+    const req: Access = {
+      id: data.id as string,
+      name: data.name,
+      configType: data.configType,
+      usage: accessProvidersMap.get(data.configType)!.usage,
+      config: {
+        apiBase: data.apiBase,
+        allowFrom: parseAllowFrom(data.allowFrom),
+        autoRegister: data.autoRegister,
+        // 注册信息由申请时自动写入，编辑时原样保留
+        registrations: op == "copy" ? {} : config.registrations ?? {},
+      },
+    };
+
+    try {
+      req.id = op == "copy" ? "" : req.id;
+      const rs = await save(req);
+
+      onAfterReq();
+
+      req.id = rs.id;
+      req.created = rs.created;
+      req.updated = rs.updated;
+      if (data.id && op == "edit") {
+        updateAccess(req);
+        return;
+      }
+
+      addAccess(req);
+    } catch (e) {
+      const err = e as ClientResponseError;
+
+      Object.entries(err.response.data as PbErrorData).forEach(([key, value]) => {
+        form.setError(key as keyof z.infer<typeof formSchema>, {
+          type: "manual",
+          message: value.message,
+        });
+      });
+
+      return;
+    }
+  };
+
+  return (
+    <>
+      <Form {...form}>
+        <form
+          onSubmit={(e) => {
+            e.stopPropagation();
+            form.handleSubmit(onSubmit)(e);
+          }}
+          className="space-y-8"
+        >
+          <FormField
+            control={form.control}
+            name="name"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.name.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.name.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="id"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="configType"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="apiBase"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.acmedns_api_base.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.acmedns_api_base.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="allowFrom"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.acmedns_allow_from.label")}</FormLabel>
+                <FormControl>
+                  <Textarea className="font-mono" placeholder={t("access.authorization.form.acmedns_allow_from.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="autoRegister"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.acmedns_auto_register.label")}</FormLabel>
+                <FormControl>
+                  <div>
+                    <Switch
+                      defaultChecked={field.value}
+                      onCheckedChange={(value) => {
+                        form.setValue(field.name, value);
+                      }}
+                    />
+                  </div>
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          {Object.keys(config.registrations ?? {}).length > 0 && op != "copy" && (
+            <div className="space-y-2">
+              <div className="text-sm font-medium">{t("access.authorization.form.acmedns_registrations.label")}</div>
+              <div className="text-xs text-muted-foreground">{t("access.authorization.form.acmedns_registrations.tips")}</div>
+              <div className="rounded-md border p-2 font-mono text-xs">
+                {Object.entries(config.registrations).map(([domain, registration]) => (
+                  <div key={domain} className="break-all">
+                    _acme-challenge.{domain} CNAME {registration.fulldomain}.
+                  </div>
+                ))}
+              </div>
+            </div>
+          )}
+
+          <FormMessage />
+
+          <div className="flex justify-end">
+            <Button type="submit">{t("common.save")}</Button>
+          </div>
+        </form>
+      </Form>
+    </>
+  );
+};
+
+export default AccessAcmeDnsForm;
@@ -17,6 +17,10 @@ import AccessNamesiloForm from "./AccessNamesiloForm";
 import AccessGodaddyForm from "./AccessGodaddyForm";
 import AccessPdnsForm from "./AccessPdnsForm";
 import AccessHttpreqForm from "./AccessHttpreqForm";
+import AccessLegoForm from "./AccessLegoForm";
+import AccessRfc2136Form from "./AccessRfc2136Form";
+import AccessAcmeDnsForm from "./AccessAcmeDnsForm";
+import AccessManualForm from "./AccessManualForm";
 import AccessLocalForm from "./AccessLocalForm";
 import AccessSSHForm from "./AccessSSHForm";
 import AccessWebhookForm from "./AccessWebhookForm";
@@ -175,6 +179,50 @@ const AccessEditDialog = ({ trigger, op, data, className }: AccessEditProps) =>
         />
       );
       break;
+    case "lego":
+      childComponent = (
+        <AccessLegoForm
+          data={data}
+          op={op}
+          onAfterReq={() => {
+            setOpen(false);
+          }}
+        />
+      );
+      break;
+    case "rfc2136":
+      childComponent = (
+        <AccessRfc2136Form
+          data={data}
+          op={op}
+          onAfterReq={() => {
+            setOpen(false);
+          }}
+        />
+      );
+      break;
+    case "acmedns":
+      childComponent = (
+        <AccessAcmeDnsForm
+          data={data}
+          op={op}
+          onAfterReq={() => {
+            setOpen(false);
+          }}
+        />
+      );
+      break;
+    case "manual":
+      childComponent = (
+        <AccessManualForm
+          data={data}
+          op={op}
+          onAfterReq={() => {
+            setOpen(false);
+          }}
+        />
+      );
+      break;
     case "local":
       childComponent = (
         <AccessLocalForm
@@ -0,0 +1,218 @@
+import { useForm } from "react-hook-form";
+import { useTranslation } from "react-i18next";
+import z from "zod";
+import { zodResolver } from "@hookform/resolvers/zod";
+import { ClientResponseError } from "pocketbase";
+
+import { Button } from "@/components/ui/button";
+import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
+import { Input } from "@/components/ui/input";
+import { Textarea } from "@/components/ui/textarea";
+import { PbErrorData } from "@/domain/base";
+import { accessProvidersMap, accessTypeFormSchema, type Access, type LegoConfig } from "@/domain/access";
+import { save } from "@/repository/access";
+import { useConfigContext } from "@/providers/config";
+
+// 每行一个 KEY=VALUE，KEY 为 lego 文档中的环境变量名
+const parseOptions = (text: string): Record<string, string> | undefined => {
+  const options: Record<string, string> = {};
+
+  for (const line of text.split("\n")) {
+    if (!line.trim()) continue;
+
+    const idx = line.indexOf("=");
+    if (idx <= 0) return undefined;
+
+    const key = line.slice(0, idx).trim();
+    if (!/^[A-Z][A-Z0-9_]*$/.test(key) || key.startsWith("LEGO_")) return undefined;
+
+    options[key] = line.slice(idx + 1).trim();
+  }
+
+  return options;
+};
+
+const formatOptions = (options?: Record<string, string>) => {
+  return Object.entries(options ?? {})
+    .map(([key, value]) => `${key}=${value}`)
+    .join("\n");
+};
+
+type AccessLegoFormProps = {
+  op: "add" | "edit" | "copy";
+  data?: Access;
+  onAfterReq: () => void;
+};
+
+const AccessLegoForm = ({ data, op, onAfterReq }: AccessLegoFormProps) => {
+  const { addAccess, updateAccess } = useConfigContext();
+  const { t } = useTranslation();
+  const formSchema = z.object({
+    id: z.string().optional(),
+    name: z
+      .string()
+      .min(1, "access.authorization.form.name.placeholder")
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    configType: accessTypeFormSchema,
+    provider: z
+      .string()
+      .min(1, "access.authorization.form.lego_provider.placeholder")
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    options: z.string().refine((v) => parseOptions(v) !== undefined, "access.authorization.form.lego_options.invalid"),
+  });
+
+  let config: LegoConfig = {
+    provider: "",
+    options: {},
+  };
+  if (data) config = data.config as LegoConfig;
+
+  const form = useForm<z.infer<typeof formSchema>>({
+    resolver: zodResolver(formSchema),
+    defaultValues: {
+      id: data?.id,
+      name: data?.name || "",
+      configType: "lego",
+      provider: config.provider,
+      options: formatOptions(config.options),
+    },
+  });
+
+  const onSubmit = async (data: z.infer<typeof formSchema>) => {
+    const req: Access = {
+      id: data.id as string,
+      name: data.name,
+      configType: data.configType,
+      usage: accessProvidersMap.get(data.configType)!.usage,
+      config: {
+        provider: data.provider.trim(),
+        options: parseOptions(data.options)!,
+      },
+    };
+
+    try {
+      req.id = op == "copy" ? "" : req.id;
+      const rs = await save(req);
+
+      onAfterReq();
+
+      req.id = rs.id;
+      req.created = rs.created;
+      req.updated = rs.updated;
+      if (data.id && op == "edit") {
+        updateAccess(req);
+        return;
+      }
+
+      addAccess(req);
+    } catch (e) {
+      const err = e as ClientResponseError;
+
+      Object.entries(err.response.data as PbErrorData).forEach(([key, value]) => {
+        form.setError(key as keyof z.infer<typeof formSchema>, {
+          type: "manual",
+          message: value.message,
+        });
+      });
+
+      return;
+    }
+  };
+
+  return (
+    <>
+      <Form {...form}>
+        <form
+          onSubmit={(e) => {
+            e.stopPropagation();
+            form.handleSubmit(onSubmit)(e);
+          }}
+          className="space-y-8"
+        >
+          <FormField
+            control={form.control}
+            name="name"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.name.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.name.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="id"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="configType"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="provider"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.lego_provider.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.lego_provider.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="options"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.lego_options.label")}</FormLabel>
+                <FormControl>
+                  <Textarea className="h-40 font-mono" placeholder={t("access.authorization.form.lego_options.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormMessage />
+
+          <div className="flex justify-end">
+            <Button type="submit">{t("common.save")}</Button>
+          </div>
+        </form>
+      </Form>
+    </>
+  );
+};
+
+export default AccessLegoForm;
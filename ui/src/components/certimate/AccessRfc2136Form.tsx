@@ -0,0 +1,248 @@
+import { useForm } from "react-hook-form";
+import { useTranslation } from "react-i18next";
+import z from "zod";
+import { zodResolver } from "@hookform/resolvers/zod";
+import { ClientResponseError } from "pocketbase";
+
+import { Button } from "@/components/ui/button";
+import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
+import { Input } from "@/components/ui/input";
+import { Select, SelectContent, SelectGroup, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { PbErrorData } from "@/domain/base";
+import { accessProvidersMap, accessTypeFormSchema, type Access, type Rfc2136Config } from "@/domain/access";
+import { save } from "@/repository/access";
+import { useConfigContext } from "@/providers/config";
+
+type AccessRfc2136FormProps = {
+  op: "add" | "edit" | "copy";
+  data?: Access;
+  onAfterReq: () => void;
+};
+
+const AccessRfc2136Form = ({ data, op, onAfterReq }: AccessRfc2136FormProps) => {
+  const { addAccess, updateAccess } = useConfigContext();
+  const { t } = useTranslation();
+  const formSchema = z.object({
+    id: z.string().optional(),
+    name: z
+      .string()
+      .min(1, "access.authorization.form.name.placeholder")
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    configType: accessTypeFormSchema,
+    nameserver: z
+      .string()
+      .min(1, "access.authorization.form.rfc2136_nameserver.placeholder")
+      .max(256, t("common.errmsg.string_max", { max: 256 })),
+    tsigKey: z.string().max(256, t("common.errmsg.string_max", { max: 256 })),
+    tsigAlgorithm: z.enum(["hmac-sha1", "hmac-sha224", "hmac-sha256", "hmac-sha384", "hmac-sha512"]),
+    tsigSecret: z.string().max(512, t("common.errmsg.string_max", { max: 512 })),
+  });
+
+  let config: Rfc2136Config = {
+    nameserver: "",
+    tsigKey: "",
+    tsigAlgorithm: "hmac-sha256",
+    tsigSecret: "",
+  };
+  if (data) config = data.config as Rfc2136Config;
+
+  const form = useForm<z.infer<typeof formSchema>>({
+    resolver: zodResolver(formSchema),
+    defaultValues: {
+      id: data?.id,
+      name: data?.name || "",
+      configType: "rfc2136",
+      nameserver: config.nameserver,
+      tsigKey: config.tsigKey,
+      tsigAlgorithm: config.tsigAlgorithm,
+      tsigSecret: config.tsigSecret,
+    },
+  });
+
+  const onSubmit = async (data: z.infer<typeof formSchema>) => {
+    const req: Access = {
+      id: data.id as string,
+      name: data.name,
+      configType: data.configType,
+      usage: accessProvidersMap.get(data.configType)!.usage,
+      config: {
+        nameserver: data.nameserver,
+        tsigKey: data.tsigKey,
+        tsigAlgorithm: data.tsigAlgorithm,
+        tsigSecret: data.tsigSecret,
+      },
+    };
+
+    try {
+      req.id = op == "copy" ? "" : req.id;
+      const rs = await save(req);
+
+      onAfterReq();
+
+      req.id = rs.id;
+      req.created = rs.created;
+      req.updated = rs.updated;
+      if (data.id && op == "edit") {
+        updateAccess(req);
+        return;
+      }
+
+      addAccess(req);
+    } catch (e) {
+      const err = e as ClientResponseError;
+
+      Object.entries(err.response.data as PbErrorData).forEach(([key, value]) => {
+        form.setError(key as keyof z.infer<typeof formSchema>, {
+          type: "manual",
+          message: value.message,
+        });
+      });
+
+      return;
+    }
+  };
+
+  return (
+    <>
+      <Form {...form}>
+        <form
+          onSubmit={(e) => {
+            e.stopPropagation();
+            form.handleSubmit(onSubmit)(e);
+          }}
+          className="space-y-8"
+        >
+          <FormField
+            control={form.control}
+            name="name"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.name.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.name.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="id"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="configType"
+            render={({ field }) => (
+              <FormItem className="hidden">
+                <FormLabel>{t("access.authorization.form.config.label")}</FormLabel>
+                <FormControl>
+                  <Input {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="nameserver"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.rfc2136_nameserver.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.rfc2136_nameserver.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="tsigKey"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.rfc2136_tsig_key.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.rfc2136_tsig_key.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="tsigAlgorithm"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.rfc2136_tsig_algorithm.label")}</FormLabel>
+                <Select
+                  {...field}
+                  value={field.value}
+                  onValueChange={(value) => {
+                    form.setValue("tsigAlgorithm", value as z.infer<typeof formSchema>["tsigAlgorithm"]);
+                  }}
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectGroup>
+                      <SelectItem value="hmac-sha1">HMAC-SHA1</SelectItem>
+                      <SelectItem value="hmac-sha224">HMAC-SHA224</SelectItem>
+                      <SelectItem value="hmac-sha256">HMAC-SHA256</SelectItem>
+                      <SelectItem value="hmac-sha384">HMAC-SHA384</SelectItem>
+                      <SelectItem value="hmac-sha512">HMAC-SHA512</SelectItem>
+                    </SelectGroup>
+                  </SelectContent>
+                </Select>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="tsigSecret"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>{t("access.authorization.form.rfc2136_tsig_secret.label")}</FormLabel>
+                <FormControl>
+                  <Input placeholder={t("access.authorization.form.rfc2136_tsig_secret.placeholder")} {...field} />
+                </FormControl>
+
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormMessage />
+
+          <div className="flex justify-end">
+            <Button type="submit">{t("common.save")}</Button>
+          </div>
+        </form>
+      </Form>
+    </>
+  );
+};
+
+export default AccessRfc2136Form;
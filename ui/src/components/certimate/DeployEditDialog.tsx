@@ -7,6 +7,7 @@ import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, D
 import { Label } from "@/components/ui/label";
 import { Select, SelectContent, SelectGroup, SelectItem, SelectLabel, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { ScrollArea } from "@/components/ui/scroll-area";
+import { Switch } from "@/components/ui/switch";
 import AccessEditDialog from "./AccessEditDialog";
 import { Context as DeployEditContext, type DeployEditContext as DeployEditContextType } from "./DeployEdit";
 import DeployToAliyunOSS from "./DeployToAliyunOSS";
@@ -289,6 +290,19 @@ const DeployEditDialog = ({ trigger, deployConfig, onSave }: DeployEditDialogPro
                 <div className="text-red-500 text-sm mt-1">{errors.access}</div>
               </div>
 
+              {/* 测试目标 */}
+              <div className="mt-8">
+                <Label>{t("domain.deployment.form.test.label")}</Label>
+                <div className="text-muted-foreground text-sm mt-1">{t("domain.deployment.form.test.tips")}</div>
+                <Switch
+                  className="mt-2"
+                  checked={locDeployConfig.test ?? false}
+                  onCheckedChange={(val) => {
+                    setConfig({ ...locDeployConfig, test: val });
+                  }}
+                />
+              </div>
+
               {/* 其他参数 */}
               <div className="mt-8">{childComponent}</div>
             </div>
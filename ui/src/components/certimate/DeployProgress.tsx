@@ -2,9 +2,10 @@ import { useTranslation } from "react-i18next";
 
 import { Separator } from "@/components/ui/separator";
 import { cn } from "@/lib/utils";
+import { Pahse } from "@/domain/deployment";
 
 type DeployProgressProps = {
-  phase?: "check" | "apply" | "deploy";
+  phase?: Pahse;
   phaseSuccess?: boolean;
 };
 
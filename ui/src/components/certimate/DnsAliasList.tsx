@@ -0,0 +1,75 @@
+import { useTranslation } from "react-i18next";
+import { Plus, Trash2 } from "lucide-react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Select, SelectContent, SelectGroup, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { accessProvidersMap, type Access } from "@/domain/access";
+import { type DnsAliasConfig } from "@/domain/domain";
+
+type DnsAliasListProps = {
+  value: DnsAliasConfig[];
+  accesses: Access[];
+  onValueChange: (value: DnsAliasConfig[]) => void;
+};
+
+const DnsAliasList = ({ value, accesses, onValueChange }: DnsAliasListProps) => {
+  const { t } = useTranslation();
+
+  const handleChange = (index: number, alias: Partial<DnsAliasConfig>) => {
+    onValueChange(value.map((item, i) => (i == index ? { ...item, ...alias } : item)));
+  };
+
+  return (
+    <div className="space-y-2">
+      {value.map((alias, index) => (
+        <div key={index} className="flex items-center space-x-2">
+          <Input
+            className="w-1/3"
+            placeholder={t("domain.application.form.dns_aliases.domain.placeholder")}
+            value={alias.domain}
+            onChange={(e) => handleChange(index, { domain: e.target.value.trim() })}
+          />
+          <Input
+            className="w-1/3"
+            placeholder={t("domain.application.form.dns_aliases.alias_domain.placeholder")}
+            value={alias.aliasDomain}
+            onChange={(e) => handleChange(index, { aliasDomain: e.target.value.trim() })}
+          />
+          <Select value={alias.access} onValueChange={(access) => handleChange(index, { access })}>
+            <SelectTrigger className="w-1/3">
+              <SelectValue placeholder={t("domain.application.form.dns_aliases.access.placeholder")} />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectGroup>
+                {accesses.map((item) => (
+                  <SelectItem key={item.id} value={item.id}>
+                    <div className="flex items-center space-x-2">
+                      <img className="w-6" src={accessProvidersMap.get(item.configType)?.icon} />
+                      <div>{item.name}</div>
+                    </div>
+                  </SelectItem>
+                ))}
+              </SelectGroup>
+            </SelectContent>
+          </Select>
+          <Trash2 size={16} className="cursor-pointer shrink-0" onClick={() => onValueChange(value.filter((_, i) => i != index))} />
+        </div>
+      ))}
+
+      <Button
+        type="button"
+        variant="outline"
+        size="sm"
+        onClick={() => {
+          onValueChange([...value, { domain: "", aliasDomain: "", access: "" }]);
+        }}
+      >
+        <Plus size={14} className="mr-1" />
+        {t("common.add")}
+      </Button>
+    </div>
+  );
+};
+
+export default DnsAliasList;
@@ -0,0 +1,69 @@
+import { useTranslation } from "react-i18next";
+import { Plus, Trash2 } from "lucide-react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Select, SelectContent, SelectGroup, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { accessProvidersMap, type Access } from "@/domain/access";
+import { type DnsProviderConfig } from "@/domain/domain";
+
+type DnsProviderListProps = {
+  value: DnsProviderConfig[];
+  accesses: Access[];
+  onValueChange: (value: DnsProviderConfig[]) => void;
+};
+
+const DnsProviderList = ({ value, accesses, onValueChange }: DnsProviderListProps) => {
+  const { t } = useTranslation();
+
+  const handleChange = (index: number, mapping: Partial<DnsProviderConfig>) => {
+    onValueChange(value.map((item, i) => (i == index ? { ...item, ...mapping } : item)));
+  };
+
+  return (
+    <div className="space-y-2">
+      {value.map((mapping, index) => (
+        <div key={index} className="flex items-center space-x-2">
+          <Input
+            className="w-1/2"
+            placeholder={t("domain.application.form.dns_providers.pattern.placeholder")}
+            value={mapping.pattern}
+            onChange={(e) => handleChange(index, { pattern: e.target.value.trim() })}
+          />
+          <Select value={mapping.access} onValueChange={(access) => handleChange(index, { access })}>
+            <SelectTrigger className="w-1/2">
+              <SelectValue placeholder={t("domain.application.form.dns_providers.access.placeholder")} />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectGroup>
+                {accesses.map((item) => (
+                  <SelectItem key={item.id} value={item.id}>
+                    <div className="flex items-center space-x-2">
+                      <img className="w-6" src={accessProvidersMap.get(item.configType)?.icon} />
+                      <div>{item.name}</div>
+                    </div>
+                  </SelectItem>
+                ))}
+              </SelectGroup>
+            </SelectContent>
+          </Select>
+          <Trash2 size={16} className="cursor-pointer shrink-0" onClick={() => onValueChange(value.filter((_, i) => i != index))} />
+        </div>
+      ))}
+
+      <Button
+        type="button"
+        variant="outline"
+        size="sm"
+        onClick={() => {
+          onValueChange([...value, { pattern: "", access: "" }]);
+        }}
+      >
+        <Plus size={14} className="mr-1" />
+        {t("common.add")}
+      </Button>
+    </div>
+  );
+};
+
+export default DnsProviderList;
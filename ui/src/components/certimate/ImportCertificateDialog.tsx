@@ -0,0 +1,200 @@
+import { useRef, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+import Show from "@/components/Show";
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, DialogTitle, DialogTrigger } from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Select, SelectContent, SelectGroup, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { Switch } from "@/components/ui/switch";
+import { Textarea } from "@/components/ui/textarea";
+import { useToast } from "@/components/ui/use-toast";
+import { readFileBase64 } from "@/lib/file";
+import { importCertificate, type ImportCertificateReq } from "@/api/domains";
+
+type ImportCertificateDialogProps = {
+  // 为空时按证书中的域名新建域名记录
+  domainId?: string;
+  trigger: React.ReactNode;
+};
+
+const ImportCertificateDialog = ({ domainId, trigger }: ImportCertificateDialogProps) => {
+  const { t } = useTranslation();
+  const toast = useToast();
+
+  const [open, setOpen] = useState(false);
+  const [req, setReq] = useState<ImportCertificateReq>({ format: "pem", deploy: true });
+  const [fileName, setFileName] = useState("");
+
+  const fileInputRef = useRef<HTMLInputElement | null>(null);
+
+  const handleOpenChange = (open: boolean) => {
+    setOpen(open);
+    if (!open) return;
+
+    setReq({ domainId, format: "pem", deploy: true });
+    setFileName("");
+  };
+
+  const handleFileChange = async (event: React.ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0];
+    if (!file) return;
+
+    setFileName(file.name);
+    setReq({ ...req, data: await readFileBase64(file) });
+  };
+
+  const handleImportClick = async () => {
+    try {
+      await importCertificate(req);
+
+      toast.toast({
+        title: t("domain.import.succeeded.message"),
+        description: t("domain.import.succeeded.tips"),
+      });
+      setOpen(false);
+    } catch (e) {
+      toast.toast({
+        title: t("domain.import.failed.message"),
+        description: (e as Error).message,
+        variant: "destructive",
+      });
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={handleOpenChange}>
+      <DialogTrigger asChild>{trigger}</DialogTrigger>
+      <DialogContent className="sm:max-w-[600px]">
+        <DialogHeader>
+          <DialogTitle>{t("domain.import")}</DialogTitle>
+          <DialogDescription>{domainId ? t("domain.import.tips.update") : t("domain.import.tips.create")}</DialogDescription>
+        </DialogHeader>
+
+        <div>
+          <Label>{t("domain.import.form.format.label")}</Label>
+          <Select
+            value={req.format}
+            onValueChange={(value) => {
+              setReq({ ...req, format: value as ImportCertificateReq["format"], data: undefined });
+              setFileName("");
+            }}
+          >
+            <SelectTrigger className="mt-1">
+              <SelectValue />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectGroup>
+                <SelectItem value="pem">PEM</SelectItem>
+                <SelectItem value="pfx">PFX</SelectItem>
+                <SelectItem value="jks">JKS</SelectItem>
+              </SelectGroup>
+            </SelectContent>
+          </Select>
+        </div>
+
+        <Show
+          when={req.format == "pem"}
+          fallback={
+            <>
+              <div>
+                <Label>{t("domain.import.form.file.label")}</Label>
+                <div className="mt-1">
+                  <Button type={"button"} variant={"secondary"} size={"sm"} className="w-48" onClick={() => fileInputRef.current?.click()}>
+                    {fileName ? fileName : t("domain.import.form.file.placeholder")}
+                  </Button>
+                  <Input ref={fileInputRef} className="hidden" hidden type="file" onChange={handleFileChange} />
+                </div>
+              </div>
+
+              <div>
+                <Label>{t("domain.import.form.password.label")}</Label>
+                <Input
+                  className="mt-1"
+                  type="password"
+                  value={req.password ?? ""}
+                  onChange={(e) => {
+                    setReq({ ...req, password: e.target.value });
+                  }}
+                />
+              </div>
+
+              <Show when={req.format == "jks"}>
+                <div>
+                  <Label>{t("domain.import.form.jks_alias.label")}</Label>
+                  <Input
+                    className="mt-1"
+                    placeholder={t("domain.import.form.jks_alias.placeholder")}
+                    value={req.jksAlias ?? ""}
+                    onChange={(e) => {
+                      setReq({ ...req, jksAlias: e.target.value.trim() });
+                    }}
+                  />
+                </div>
+
+                <div>
+                  <Label>{t("domain.import.form.jks_keypass.label")}</Label>
+                  <Input
+                    className="mt-1"
+                    type="password"
+                    placeholder={t("domain.import.form.jks_keypass.placeholder")}
+                    value={req.jksKeypass ?? ""}
+                    onChange={(e) => {
+                      setReq({ ...req, jksKeypass: e.target.value });
+                    }}
+                  />
+                </div>
+              </Show>
+            </>
+          }
+        >
+          <div>
+            <Label>{t("domain.import.form.certificate.label")}</Label>
+            <Textarea
+              className="mt-1 font-mono text-xs"
+              rows={6}
+              placeholder={t("domain.import.form.certificate.placeholder")}
+              value={req.certificate ?? ""}
+              onChange={(e) => {
+                setReq({ ...req, certificate: e.target.value });
+              }}
+            />
+          </div>
+
+          <div>
+            <Label>{t("domain.import.form.private_key.label")}</Label>
+            <Textarea
+              className="mt-1 font-mono text-xs"
+              rows={6}
+              placeholder={t("domain.import.form.private_key.placeholder")}
+              value={req.privateKey ?? ""}
+              onChange={(e) => {
+                setReq({ ...req, privateKey: e.target.value });
+              }}
+            />
+          </div>
+        </Show>
+
+        <div className="flex items-center justify-between">
+          <div>
+            <Label>{t("domain.import.form.deploy.label")}</Label>
+            <div className="text-muted-foreground text-sm mt-1">{t("domain.import.form.deploy.tips")}</div>
+          </div>
+          <Switch
+            checked={req.deploy ?? false}
+            onCheckedChange={(val) => {
+              setReq({ ...req, deploy: val });
+            }}
+          />
+        </div>
+
+        <DialogFooter>
+          <Button onClick={handleImportClick}>{t("domain.import")}</Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default ImportCertificateDialog;
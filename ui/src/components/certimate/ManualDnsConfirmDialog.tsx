@@ -0,0 +1,95 @@
+import { useState } from "react";
+import { useTranslation } from "react-i18next";
+
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, DialogTitle, DialogTrigger } from "@/components/ui/dialog";
+import { useToast } from "@/components/ui/use-toast";
+import { convertZulu2Beijing } from "@/lib/time";
+import { confirmManualDnsChallenges, listManualDnsChallenges, type ManualDnsChallenge } from "@/api/domains";
+
+type ManualDnsConfirmDialogProps = {
+  domainId: string;
+};
+
+const ManualDnsConfirmDialog = ({ domainId }: ManualDnsConfirmDialogProps) => {
+  const { t } = useTranslation();
+  const toast = useToast();
+
+  const [open, setOpen] = useState(false);
+  const [challenges, setChallenges] = useState<ManualDnsChallenge[]>([]);
+
+  const handleOpenChange = async (open: boolean) => {
+    setOpen(open);
+    if (!open) return;
+
+    try {
+      setChallenges(await listManualDnsChallenges(domainId));
+    } catch (e) {
+      setChallenges([]);
+      toast.toast({
+        title: t("domain.manual_dns.failed.message"),
+        description: (e as Error).message,
+        variant: "destructive",
+      });
+    }
+  };
+
+  const handleConfirmClick = async () => {
+    try {
+      await confirmManualDnsChallenges(domainId);
+
+      toast.toast({
+        title: t("domain.manual_dns.succeeded.message"),
+        description: t("domain.manual_dns.succeeded.tips"),
+      });
+      setOpen(false);
+    } catch (e) {
+      toast.toast({
+        title: t("domain.manual_dns.failed.message"),
+        description: (e as Error).message,
+        variant: "destructive",
+      });
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={handleOpenChange}>
+      <DialogTrigger asChild>
+        <Button variant={"link"} className="p-0">
+          {t("domain.manual_dns")}
+        </Button>
+      </DialogTrigger>
+      <DialogContent className="sm:max-w-[600px]">
+        <DialogHeader>
+          <DialogTitle>{t("domain.manual_dns")}</DialogTitle>
+          <DialogDescription>{t("domain.manual_dns.tips")}</DialogDescription>
+        </DialogHeader>
+
+        {challenges.length == 0 ? (
+          <div className="text-sm text-muted-foreground">{t("domain.manual_dns.empty")}</div>
+        ) : (
+          <div className="space-y-2">
+            {challenges.map((challenge) => (
+              <div key={challenge.id} className="rounded-md border p-2 font-mono text-xs break-all">
+                <div>
+                  {challenge.fqdn} TXT "{challenge.value}"
+                </div>
+                <div className="text-muted-foreground">
+                  {t("domain.manual_dns.expired_at", { time: convertZulu2Beijing(challenge.expiredAt) })}
+                </div>
+              </div>
+            ))}
+          </div>
+        )}
+
+        <DialogFooter>
+          <Button disabled={challenges.length == 0} onClick={handleConfirmClick}>
+            {t("domain.manual_dns.confirm")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default ManualDnsConfirmDialog;
@@ -0,0 +1,93 @@
+import { useState } from "react";
+import { useTranslation } from "react-i18next";
+
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogDescription, DialogHeader, DialogTitle, DialogTrigger } from "@/components/ui/dialog";
+import { ScrollArea } from "@/components/ui/scroll-area";
+import { cn } from "@/lib/utils";
+import { preflight, type PreflightCheck, type PreflightReport } from "@/api/domains";
+
+type PreflightDialogProps = {
+  domainId: string;
+};
+
+const statusClassNames: Record<PreflightCheck["status"], string> = {
+  pass: "text-green-600",
+  warn: "text-yellow-600",
+  fail: "text-red-600",
+  skip: "text-muted-foreground",
+};
+
+const PreflightDialog = ({ domainId }: PreflightDialogProps) => {
+  const { t } = useTranslation();
+
+  const [open, setOpen] = useState(false);
+  const [loading, setLoading] = useState(false);
+  const [report, setReport] = useState<PreflightReport>();
+  const [error, setError] = useState<string>();
+
+  const handleOpenChange = async (open: boolean) => {
+    setOpen(open);
+    if (!open) return;
+
+    setLoading(true);
+    setReport(undefined);
+    setError(undefined);
+    try {
+      setReport(await preflight(domainId));
+    } catch (e) {
+      setError((e as Error).message);
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={handleOpenChange}>
+      <DialogTrigger asChild>
+        <Button variant={"link"} className="p-0">
+          {t("domain.preflight")}
+        </Button>
+      </DialogTrigger>
+      <DialogContent className="sm:max-w-[720px]">
+        <DialogHeader>
+          <DialogTitle>{t("domain.preflight")}</DialogTitle>
+          <DialogDescription>{t("domain.preflight.tips")}</DialogDescription>
+        </DialogHeader>
+
+        {loading && <div className="text-sm text-muted-foreground">{t("domain.preflight.running")}</div>}
+
+        {error && <div className="text-sm text-red-600">{error}</div>}
+
+        {report && (
+          <ScrollArea className="max-h-[60vh]">
+            <div className={cn("mb-2 text-sm font-medium", report.passed ? "text-green-600" : "text-red-600")}>
+              {report.passed ? t("domain.preflight.passed") : t("domain.preflight.failed")}
+            </div>
+            <div className="space-y-3">
+              {report.names.map((name) => (
+                <div key={name.name} className="rounded-md border p-2">
+                  <div className="font-mono text-sm">{name.name}</div>
+                  {name.checks.map((check) => (
+                    <div key={check.type} className="mt-1 text-xs">
+                      <span className={cn("inline-block w-16 font-medium", statusClassNames[check.status])}>{t(`domain.preflight.status.${check.status}`)}</span>
+                      <span className="inline-block w-16">{t(`domain.preflight.check.${check.type}`)}</span>
+                      <span className="break-all">{check.message}</span>
+                      {check.records?.map((record) => (
+                        <div key={record} className="ml-32 font-mono text-muted-foreground break-all">
+                          {record}
+                        </div>
+                      ))}
+                    </div>
+                  ))}
+                </div>
+              ))}
+            </div>
+          </ScrollArea>
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default PreflightDialog;
@@ -0,0 +1,102 @@
+import { useState } from "react";
+import { useTranslation } from "react-i18next";
+
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, DialogTitle, DialogTrigger } from "@/components/ui/dialog";
+import { Label } from "@/components/ui/label";
+import { Select, SelectContent, SelectGroup, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { Switch } from "@/components/ui/switch";
+import { useToast } from "@/components/ui/use-toast";
+import { revoke } from "@/api/domains";
+
+// 证书订阅者可以使用的 RFC 5280 吊销原因代码
+const revocationReasons = [0, 1, 3, 4, 5];
+
+type RevokeDialogProps = {
+  domainId: string;
+};
+
+const RevokeDialog = ({ domainId }: RevokeDialogProps) => {
+  const { t } = useTranslation();
+  const toast = useToast();
+
+  const [open, setOpen] = useState(false);
+  const [reason, setReason] = useState(1);
+  const [reissue, setReissue] = useState(true);
+
+  const handleOpenChange = (open: boolean) => {
+    setOpen(open);
+    if (!open) return;
+
+    setReason(1);
+    setReissue(true);
+  };
+
+  const handleConfirmClick = async () => {
+    try {
+      await revoke(domainId, reason, reissue);
+
+      toast.toast({
+        title: t("domain.revoke.succeeded.message"),
+        description: reissue ? t("domain.revoke.succeeded.tips") : t("domain.revoke.succeeded.tips_no_reissue"),
+      });
+      setOpen(false);
+    } catch (e) {
+      toast.toast({
+        title: t("domain.revoke.failed.message"),
+        description: (e as Error).message,
+        variant: "destructive",
+      });
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={handleOpenChange}>
+      <DialogTrigger asChild>
+        <Button variant={"link"} className="p-0">
+          {t("domain.revoke")}
+        </Button>
+      </DialogTrigger>
+      <DialogContent className="sm:max-w-[500px]">
+        <DialogHeader>
+          <DialogTitle>{t("domain.revoke")}</DialogTitle>
+          <DialogDescription>{t("domain.revoke.confirm")}</DialogDescription>
+        </DialogHeader>
+
+        <div>
+          <Label>{t("domain.revoke.reason.label")}</Label>
+          <Select value={reason.toString()} onValueChange={(value) => setReason(Number(value))}>
+            <SelectTrigger className="mt-1">
+              <SelectValue />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectGroup>
+                {revocationReasons.map((code) => (
+                  <SelectItem key={code} value={code.toString()}>
+                    {t(`domain.revoke.reason.option.${code}`)}
+                  </SelectItem>
+                ))}
+              </SelectGroup>
+            </SelectContent>
+          </Select>
+        </div>
+
+        <div className="flex items-center justify-between">
+          <div>
+            <Label>{t("domain.revoke.reissue.label")}</Label>
+            <div className="text-muted-foreground text-sm mt-1">{t("domain.revoke.reissue.tips")}</div>
+          </div>
+          <Switch checked={reissue} onCheckedChange={setReissue} />
+        </div>
+
+        <DialogFooter>
+          <Button variant={"destructive"} onClick={handleConfirmClick}>
+            {t("domain.revoke")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default RevokeDialog;
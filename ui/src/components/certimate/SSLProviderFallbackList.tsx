@@ -0,0 +1,62 @@
+import { useTranslation } from "react-i18next";
+
+import { Label } from "@/components/ui/label";
+import { Switch } from "@/components/ui/switch";
+
+// 可作为备用 CA 的 ACME CA，内置 CA 不参与切换
+const fallbackProviders = ["letsencrypt", "zerossl", "gts", "custom"];
+
+type SSLProviderFallbackListProps = {
+  value: string[];
+  // 主 CA，不能同时作为备用 CA
+  primary?: string;
+  onValueChange: (value: string[]) => void;
+};
+
+const SSLProviderFallbackList = ({ value, primary, onValueChange }: SSLProviderFallbackListProps) => {
+  const { t } = useTranslation();
+
+  const getProviderName = (provider: string) => {
+    switch (provider) {
+      case "letsencrypt":
+        return "Let's Encrypt";
+      case "zerossl":
+        return "ZeroSSL";
+      case "gts":
+        return "Google Trust Services";
+      default:
+        return t("settings.ca.custom.label");
+    }
+  };
+
+  // 按开启的先后顺序依次尝试
+  const handleCheckedChange = (provider: string, checked: boolean) => {
+    if (checked) {
+      onValueChange([...value.filter((item) => item != provider), provider]);
+    } else {
+      onValueChange(value.filter((item) => item != provider));
+    }
+  };
+
+  return (
+    <div className="flex flex-col space-y-2">
+      {fallbackProviders
+        .filter((provider) => provider != primary)
+        .map((provider) => {
+          const idx = value.indexOf(provider);
+
+          return (
+            <div key={provider} className="flex items-center justify-between">
+              <Label className="font-normal">
+                {getProviderName(provider)}
+                {idx >= 0 && <span className="ml-2 text-muted-foreground">#{idx + 1}</span>}
+              </Label>
+              <Switch checked={idx >= 0} onCheckedChange={(checked) => handleCheckedChange(provider, checked)} />
+            </div>
+          );
+        })}
+    </div>
+  );
+};
+
+export default SSLProviderFallbackList;
@@ -151,13 +151,16 @@ const StringEdit = ({ trigger, value, onValueChange, op = "add", valueType }: St
     setCurrentValue(value);
   }, [value]);
 
-  const domainSchema = z.string().regex(/^(?:\*\.)?([a-zA-Z0-9-]+\.)+[a-zA-Z]{2,}$/, {
-    message: t("common.errmsg.domain_invalid"),
-  });
-
   const ipSchema = z.string().ip({ message: t("common.errmsg.ip_invalid") });
 
-  const schedules: Record<ValueType, z.ZodString> = {
+  // 证书支持 IP 地址标识（RFC 8738）
+  const domainSchema = z
+    .string()
+    .refine((val) => /^(?:\*\.)?([a-zA-Z0-9-]+\.)+[a-zA-Z]{2,}$/.test(val) || ipSchema.safeParse(val).success, {
+      message: t("common.errmsg.domain_invalid"),
+    });
+
+  const schedules: Record<ValueType, z.ZodTypeAny> = {
     domain: domainSchema,
     dns: ipSchema,
     host: ipSchema,
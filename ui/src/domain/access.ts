@@ -26,6 +26,10 @@ export const accessProvidersMap: Map<AccessProvider["type"], AccessProvider> = n
     ["godaddy", "common.provider.godaddy", "/imgs/providers/godaddy.svg", "apply", "godaddy"],
     ["pdns", "common.provider.pdns", "/imgs/providers/pdns.svg", "apply", "powerdns:pdns"],
     ["httpreq", "common.provider.httpreq", "/imgs/providers/httpreq.svg", "apply", "httpreq"],
+    ["lego", "common.provider.lego", "/imgs/providers/lego.svg", "apply", "lego:dns"],
+    ["rfc2136", "common.provider.rfc2136", "/imgs/providers/rfc2136.svg", "apply", "rfc2136:nsupdate:bind:knot:tsig"],
+    ["acmedns", "common.provider.acmedns", "/imgs/providers/acmedns.svg", "apply", "acmedns:acme-dns:joohoi"],
+    ["manual", "common.provider.manual", "/imgs/providers/manual.svg", "apply", "manual:shoudong:手动"],
     ["local", "common.provider.local", "/imgs/providers/local.svg", "deploy", "local:bendi:本地"],
     ["ssh", "common.provider.ssh", "/imgs/providers/ssh.svg", "deploy", "ssh"],
     ["webhook", "common.provider.webhook", "/imgs/providers/webhook.svg", "deploy", "webhook"],
@@ -55,6 +59,10 @@ export const accessTypeFormSchema = z.union(
     z.literal("k8s"),
     z.literal("volcengine"),
     z.literal("byteplus"),
+    z.literal("lego"),
+    z.literal("rfc2136"),
+    z.literal("acmedns"),
+    z.literal("manual"),
   ],
   { message: "access.authorization.form.type.placeholder" }
 );
@@ -83,7 +91,11 @@ export type Access = {
     | UnicloudConfig
     | KubernetesConfig
     | VolcengineConfig
-    | ByteplusConfig;
+    | ByteplusConfig
+    | LegoConfig
+    | Rfc2136Config
+    | AcmeDnsConfig
+    | ManualConfig;
   deleted?: string;
   created?: string;
   updated?: string;
@@ -186,3 +198,34 @@ export type ByteplusConfig = {
   accessKey: string;
   secretKey: string;
 };
+
+export type LegoConfig = {
+  provider: string;
+  options: Record<string, string>;
+};
+
+export type Rfc2136Config = {
+  nameserver: string;
+  tsigKey: string;
+  tsigAlgorithm: "hmac-sha1" | "hmac-sha224" | "hmac-sha256" | "hmac-sha384" | "hmac-sha512";
+  tsigSecret: string;
+};
+
+export type AcmeDnsConfig = {
+  apiBase: string;
+  allowFrom: string[];
+  autoRegister: boolean;
+  registrations: Record<string, AcmeDnsRegistration>;
+};
+
+export type AcmeDnsRegistration = {
+  fulldomain: string;
+  subdomain: string;
+  username: string;
+  password: string;
+  server_url: string;
+};
+
+export type ManualConfig = {
+  confirmTimeout: number;
+};
@@ -7,10 +7,13 @@ export type Deployment = {
     apply?: Log[];
     check?: Log[];
     deploy?: Log[];
+    revoke?: Log[];
   };
   phase: Pahse;
   phaseSuccess: boolean;
   wholeSuccess: boolean;
+  certificate?: string;
+  ca?: string;
   deployedAt: string;
   created: string;
   updated: string;
@@ -19,7 +22,7 @@ export type Deployment = {
   };
 };
 
-export type Pahse = "apply" | "check" | "deploy";
+export type Pahse = "apply" | "check" | "deploy" | "revoke";
 
 export type Log = {
   time: string;
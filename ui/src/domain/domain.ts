@@ -23,6 +23,20 @@ export type Domain = {
   rightnow?: boolean;
   certificate?: string;
   privateKey?: string;
+  ca?: string;
+  primaryCa?: string;
+  renewalWindowStart?: string;
+  renewalWindowEnd?: string;
+  issuedAt?: string;
+  serialNumber?: string;
+  issuer?: string;
+  sans?: string[];
+  keyAlgorithm?: string;
+  fingerprint?: string;
+  chainRoot?: string;
+  revoked?: boolean;
+  external?: boolean;
+  untrusted?: boolean;
   expand?: {
     lastDeployment?: Deployment;
   };
@@ -40,6 +54,7 @@ export type DeployConfig = {
   id?: string;
   access: string;
   type: string;
+  test?: boolean;
   config?: {
     [key: string]: string;
   } & {
@@ -54,6 +69,57 @@ export type ApplyConfig = {
   nameservers?: string;
   timeout?: number;
   disableFollowCNAME?: boolean;
+  sslProvider?: string;
+  sslProviderFallbacks?: string[];
+  renewalPercentage?: number;
+  preferredChain?: string;
+  keyMode?: "generate" | "reuse" | "csr";
+  csr?: string;
+  challengeType?: string;
+  httpChallenge?: HttpChallengeConfig;
+  tlsAlpnChallenge?: TlsAlpnChallengeConfig;
+  internalCA?: InternalCAConfig;
+  dnsAliases?: DnsAliasConfig[];
+  dnsProviders?: DnsProviderConfig[];
+  staging?: boolean;
+  profile?: string;
+};
+
+export type DnsAliasConfig = {
+  domain: string;
+  aliasDomain: string;
+  access: string;
+};
+
+export type DnsProviderConfig = {
+  pattern: string;
+  access: string;
+};
+
+export type InternalCAConfig = {
+  commonName?: string;
+  organization?: string;
+  organizationalUnit?: string;
+  country?: string;
+  province?: string;
+  locality?: string;
+  validityDays?: number;
+};
+
+export type TlsAlpnChallengeConfig = {
+  interface?: string;
+  port?: string;
+  access?: string;
+};
+
+export type HttpChallengeConfig = {
+  solver?: string;
+  interface?: string;
+  port?: string;
+  access?: string;
+  webroot?: string;
+  region?: string;
+  bucket?: string;
 };
 
 export type Statistic = {
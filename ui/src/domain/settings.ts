@@ -85,13 +85,22 @@ export const defaultNotifyTemplate: NotifyTemplate = {
   content: "有 {COUNT} 张证书即将过期，域名分别为 {DOMAINS}，请保持关注！",
 };
 
-export type SSLProvider = "letsencrypt" | "zerossl" | "gts";
+export type SSLProvider = "letsencrypt" | "zerossl" | "gts" | "custom" | "internal";
 
 export type SSLProviderSetting = {
   provider: SSLProvider;
+  fallbacks?: SSLProvider[];
   config: {
     [key: string]: {
       [key: string]: string;
     };
   };
 };
+
+export type AcmeServerSetting = {
+  enabled: boolean;
+  allowedDomains: string[];
+  domainId: string;
+  eabKid: string;
+  eabHmacKey: string;
+};
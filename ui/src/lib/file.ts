@@ -18,6 +18,26 @@ export function readFileContent(file: File): Promise<string> {
   });
 }
 
+// 读取二进制文件，返回 Base64 编码的内容
+export function readFileBase64(file: File): Promise<string> {
+  return new Promise((resolve, reject) => {
+    const reader = new FileReader();
+
+    reader.onload = () => {
+      if (reader.result) {
+        const result = reader.result.toString();
+        resolve(result.substring(result.indexOf(",") + 1));
+      } else {
+        reject("No content found");
+      }
+    };
+
+    reader.onerror = () => reject(reader.error);
+
+    reader.readAsDataURL(file);
+  });
+}
+
 export type CustomFile = {
   name: string;
   content: string;
@@ -21,6 +21,11 @@ export const getDate = (zuluTime: string) => {
   return time.split(" ")[0];
 };
 
+export const getDiffDays = (startZuluTime: string, endZuluTime: string) => {
+  const diff = new Date(endZuluTime).getTime() - new Date(startZuluTime).getTime();
+  return Math.round(diff / (1000 * 60 * 60 * 24));
+};
+
 export const getLeftDays = (zuluTime: string) => {
   const time = convertZulu2Beijing(zuluTime);
   const date = time.split(" ")[0];
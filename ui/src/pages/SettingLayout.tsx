@@ -1,7 +1,7 @@
 import { useEffect, useState } from "react";
 import { Outlet, useLocation, useNavigate } from "react-router-dom";
 import { useTranslation } from "react-i18next";
-import { KeyRound, Megaphone, ShieldCheck, UserRound } from "lucide-react";
+import { KeyRound, Megaphone, ShieldCheck, UserRound, Users } from "lucide-react";
 
 import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
 import { Toaster } from "@/components/ui/toaster";
@@ -68,6 +68,17 @@ const SettingLayout = () => {
               <ShieldCheck size={14} />
               <div className="ml-1">{t("settings.ca.tab")}</div>
             </TabsTrigger>
+
+            <TabsTrigger
+              value="acme-accounts"
+              onClick={() => {
+                navigate("/setting/acme-accounts");
+              }}
+              className="px-5"
+            >
+              <Users size={14} />
+              <div className="ml-1">{t("settings.acme_accounts.tab")}</div>
+            </TabsTrigger>
           </TabsList>
           <TabsContent value={tabValue}>
             <div className="mt-5 w-full md:w-[45em]">
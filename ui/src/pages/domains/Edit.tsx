@@ -10,8 +10,9 @@ import { ClientResponseError } from "pocketbase";
 import { Button } from "@/components/ui/button";
 import { Breadcrumb, BreadcrumbItem, BreadcrumbLink, BreadcrumbList, BreadcrumbPage, BreadcrumbSeparator } from "@/components/ui/breadcrumb";
 import { Collapsible, CollapsibleContent, CollapsibleTrigger } from "@/components/ui/collapsible";
-import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
+import { Form, FormControl, FormDescription, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
 import { Input } from "@/components/ui/input";
+import { Textarea } from "@/components/ui/textarea";
 import { Select, SelectContent, SelectGroup, SelectItem, SelectLabel, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { Toaster } from "@/components/ui/toaster";
 import { useToast } from "@/components/ui/use-toast";
@@ -19,11 +20,15 @@ import AccessEditDialog from "@/components/certimate/AccessEditDialog";
 import DeployList from "@/components/certimate/DeployList";
 import EmailsEdit from "@/components/certimate/EmailsEdit";
 import StringList from "@/components/certimate/StringList";
+import DnsAliasList from "@/components/certimate/DnsAliasList";
+import DnsProviderList from "@/components/certimate/DnsProviderList";
+import SSLProviderFallbackList from "@/components/certimate/SSLProviderFallbackList";
+import Show from "@/components/Show";
 import { cn } from "@/lib/utils";
 import { PbErrorData } from "@/domain/base";
 import { accessProvidersMap } from "@/domain/access";
 import { EmailsSetting } from "@/domain/settings";
-import { DeployConfig, Domain } from "@/domain/domain";
+import { ApplyConfig, DeployConfig, Domain } from "@/domain/domain";
 import { save, get } from "@/repository/domains";
 import { useConfigContext } from "@/providers/config";
 import { Switch } from "@/components/ui/switch";
@@ -54,20 +59,100 @@ const Edit = () => {
     }
   }, [location.search]);
 
-  const formSchema = z.object({
-    id: z.string().optional(),
-    domain: z.string().min(1, {
-      message: "common.errmsg.domain_invalid",
-    }),
-    email: z.string().email("common.errmsg.email_invalid").optional(),
-    access: z.string().regex(/^[a-zA-Z0-9]+$/, {
-      message: "domain.application.form.access.placeholder",
-    }),
-    keyAlgorithm: z.string().optional(),
-    nameservers: z.string().optional(),
-    timeout: z.number().optional(),
-    disableFollowCNAME: z.boolean().optional(),
-  });
+  const formSchema = z
+    .object({
+      id: z.string().optional(),
+      domain: z.string().min(1, {
+        message: "common.errmsg.domain_invalid",
+      }),
+      email: z.string().email("common.errmsg.email_invalid").optional(),
+      access: z.string().optional(),
+      keyAlgorithm: z.string().optional(),
+      keyMode: z.string().optional(),
+      csr: z.string().optional(),
+      nameservers: z.string().optional(),
+      timeout: z.number().optional(),
+      disableFollowCNAME: z.boolean().optional(),
+      sslProvider: z.string().optional(),
+      challengeType: z.string().optional(),
+      httpChallenge: z
+        .object({
+          solver: z.string().optional(),
+          interface: z.string().optional(),
+          port: z.string().regex(/^\d*$/, "domain.application.form.challenge.port.invalid").optional(),
+          access: z.string().optional(),
+          webroot: z.string().optional(),
+          region: z.string().optional(),
+          bucket: z.string().optional(),
+        })
+        .optional(),
+      tlsAlpnChallenge: z
+        .object({
+          interface: z.string().optional(),
+          port: z.string().regex(/^\d*$/, "domain.application.form.challenge.port.invalid").optional(),
+          access: z.string().optional(),
+        })
+        .optional(),
+      sslProviderFallbacks: z.array(z.string()).optional(),
+      staging: z.boolean().optional(),
+      profile: z.string().optional(),
+      preferredChain: z.string().optional(),
+      dnsAliases: z
+        .array(
+          z.object({
+            domain: z.string().min(1, "domain.application.form.dns_aliases.invalid"),
+            aliasDomain: z.string().min(1, "domain.application.form.dns_aliases.invalid"),
+            access: z.string().min(1, "domain.application.form.dns_aliases.invalid"),
+          })
+        )
+        .optional(),
+      dnsProviders: z
+        .array(
+          z.object({
+            pattern: z.string().min(1, "domain.application.form.dns_providers.invalid"),
+            access: z.string().min(1, "domain.application.form.dns_providers.invalid"),
+          })
+        )
+        .optional(),
+      internalCA: z
+        .object({
+          commonName: z.string().optional(),
+          organization: z.string().optional(),
+          organizationalUnit: z.string().optional(),
+          country: z.string().optional(),
+          province: z.string().optional(),
+          locality: z.string().optional(),
+          validityDays: z.number().int().min(0, "domain.application.form.internal_ca.validity_days.invalid").optional(),
+        })
+        .optional(),
+    })
+    .superRefine((data, ctx) => {
+      if (data.keyMode == "csr" && !data.csr?.trim()) {
+        ctx.addIssue({
+          code: z.ZodIssueCode.custom,
+          path: ["csr"],
+          message: "domain.application.form.csr.placeholder",
+        });
+      }
+
+      // 仅 DNS-01 验证需要 DNS 服务商授权，内置 CA 直接签发证书，不需要验证
+      const needsAccess = data.sslProvider != "internal" && (data.challengeType || "dns-01") == "dns-01";
+      if (needsAccess && !/^[a-zA-Z0-9]+$/.test(data.access ?? "")) {
+        ctx.addIssue({
+          code: z.ZodIssueCode.custom,
+          path: ["access"],
+          message: "domain.application.form.access.placeholder",
+        });
+      }
+
+      if (data.challengeType == "http-01" && data.httpChallenge?.solver == "webroot" && !data.httpChallenge.access) {
+        ctx.addIssue({
+          code: z.ZodIssueCode.custom,
+          path: ["httpChallenge", "access"],
+          message: "domain.application.form.http_challenge.access.placeholder",
+        });
+      }
+    });
 
   const form = useForm<z.infer<typeof formSchema>>({
     resolver: zodResolver(formSchema),
@@ -77,9 +162,22 @@ const Edit = () => {
       email: "",
       access: "",
       keyAlgorithm: "RSA2048",
+      keyMode: "generate",
+      csr: "",
       nameservers: "",
       timeout: 60,
       disableFollowCNAME: true,
+      sslProvider: "",
+      challengeType: "dns-01",
+      httpChallenge: {},
+      tlsAlpnChallenge: {},
+      sslProviderFallbacks: [],
+      staging: false,
+      profile: "",
+      preferredChain: "",
+      dnsAliases: [],
+      dnsProviders: [],
+      internalCA: {},
     },
   });
 
@@ -91,29 +189,65 @@ const Edit = () => {
         email: domain.applyConfig?.email,
         access: domain.applyConfig?.access,
         keyAlgorithm: domain.applyConfig?.keyAlgorithm,
+        keyMode: domain.applyConfig?.keyMode || "generate",
+        csr: domain.applyConfig?.csr ?? "",
         nameservers: domain.applyConfig?.nameservers,
         timeout: domain.applyConfig?.timeout,
         disableFollowCNAME: domain.applyConfig?.disableFollowCNAME,
+        sslProvider: domain.applyConfig?.sslProvider ?? "",
+        challengeType: domain.applyConfig?.challengeType || "dns-01",
+        httpChallenge: domain.applyConfig?.httpChallenge ?? {},
+        tlsAlpnChallenge: domain.applyConfig?.tlsAlpnChallenge ?? {},
+        sslProviderFallbacks: domain.applyConfig?.sslProviderFallbacks ?? [],
+        staging: domain.applyConfig?.staging ?? false,
+        profile: domain.applyConfig?.profile ?? "",
+        preferredChain: domain.applyConfig?.preferredChain ?? "",
+        dnsAliases: domain.applyConfig?.dnsAliases ?? [],
+        dnsProviders: domain.applyConfig?.dnsProviders ?? [],
+        internalCA: domain.applyConfig?.internalCA ?? {},
       });
     }
   }, [domain, form]);
 
   const { toast } = useToast();
 
+  // webroot 模式支持写入 SSH 主机的网站根目录，或阿里云 OSS、腾讯云 COS 存储桶
+  const webrootAccessTypes = ["ssh", "aliyun", "tencent"];
+
+  const isObjectStorageAccess = (id?: string) => {
+    const access = accesses.find((item) => item.id == id);
+    return access?.configType == "aliyun" || access?.configType == "tencent";
+  };
+
   const onSubmit = async (data: z.infer<typeof formSchema>) => {
     const req: Domain = {
       id: data.id as string,
       crontab: "0 0 * * *",
       domain: data.domain,
       email: data.email,
-      access: data.access,
+      access: data.access ?? "",
       applyConfig: {
+        // 保留表单中未展示的申请配置
+        ...domain?.applyConfig,
         email: data.email ?? "",
-        access: data.access,
+        access: data.access ?? "",
         keyAlgorithm: data.keyAlgorithm,
+        keyMode: data.keyMode as ApplyConfig["keyMode"],
+        csr: data.keyMode == "csr" ? data.csr : "",
         nameservers: data.nameservers,
         timeout: data.timeout,
         disableFollowCNAME: data.disableFollowCNAME,
+        sslProvider: data.sslProvider,
+        challengeType: data.challengeType,
+        httpChallenge: data.challengeType == "http-01" ? data.httpChallenge : undefined,
+        tlsAlpnChallenge: data.challengeType == "tls-alpn-01" ? data.tlsAlpnChallenge : undefined,
+        sslProviderFallbacks: data.sslProviderFallbacks,
+        staging: data.staging,
+        profile: data.profile,
+        preferredChain: data.preferredChain,
+        dnsAliases: data.dnsAliases,
+        dnsProviders: data.dnsProviders,
+        internalCA: data.sslProvider == "internal" ? data.internalCA : undefined,
       },
     };
     //获取当前的小时和分钟，用于每天crontab的定时任务
@@ -298,40 +432,213 @@ const Edit = () => {
                     )}
                   />
 
-                  {/* DNS 服务商授权 */}
-                  <FormField
-                    control={form.control}
-                    name="access"
-                    render={({ field }) => (
-                      <FormItem>
-                        <FormLabel className="flex justify-between w-full">
-                          <div>{t("domain.application.form.access.label")}</div>
-                          <AccessEditDialog
-                            trigger={
-                              <div className="flex items-center font-normal cursor-pointer text-primary hover:underline">
-                                <Plus size={14} />
-                                {t("common.add")}
-                              </div>
-                            }
-                            op="add"
-                          />
-                        </FormLabel>
-                        <FormControl>
+                  {/* 验证方式 */}
+                  <Show when={form.watch("sslProvider") != "internal"}>
+                    <FormField
+                      control={form.control}
+                      name="challengeType"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel>{t("domain.application.form.challenge_type.label")}</FormLabel>
                           <Select
-                            {...field}
                             value={field.value}
                             onValueChange={(value) => {
-                              form.setValue("access", value);
+                              form.setValue("challengeType", value);
+                            }}
+                          >
+                            <SelectTrigger>
+                              <SelectValue />
+                            </SelectTrigger>
+                            <SelectContent>
+                              <SelectGroup>
+                                <SelectItem value="dns-01">DNS-01</SelectItem>
+                                <SelectItem value="http-01">HTTP-01</SelectItem>
+                                <SelectItem value="tls-alpn-01">TLS-ALPN-01</SelectItem>
+                              </SelectGroup>
+                            </SelectContent>
+                          </Select>
+
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+                  </Show>
+
+                  {/* HTTP-01 验证 */}
+                  <Show when={form.watch("sslProvider") != "internal" && form.watch("challengeType") == "http-01"}>
+                    <FormField
+                      control={form.control}
+                      name="httpChallenge.solver"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel>{t("domain.application.form.http_challenge.solver.label")}</FormLabel>
+                          <Select
+                            value={field.value || "standalone"}
+                            onValueChange={(value) => {
+                              form.setValue("httpChallenge.solver", value);
+                            }}
+                          >
+                            <SelectTrigger>
+                              <SelectValue />
+                            </SelectTrigger>
+                            <SelectContent>
+                              <SelectGroup>
+                                <SelectItem value="standalone">{t("domain.application.form.http_challenge.solver.option.standalone")}</SelectItem>
+                                <SelectItem value="router">{t("domain.application.form.http_challenge.solver.option.router")}</SelectItem>
+                                <SelectItem value="webroot">{t("domain.application.form.http_challenge.solver.option.webroot")}</SelectItem>
+                              </SelectGroup>
+                            </SelectContent>
+                          </Select>
+                          <FormDescription>{t(`domain.application.form.http_challenge.solver.tips.${field.value || "standalone"}`)}</FormDescription>
+
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+
+                    <Show when={(form.watch("httpChallenge.solver") || "standalone") == "standalone"}>
+                      <FormField
+                        control={form.control}
+                        name="httpChallenge.interface"
+                        render={({ field }) => (
+                          <FormItem>
+                            <FormLabel>{t("domain.application.form.challenge.interface.label")}</FormLabel>
+                            <FormControl>
+                              <Input placeholder={t("domain.application.form.challenge.interface.placeholder")} {...field} value={field.value ?? ""} />
+                            </FormControl>
+
+                            <FormMessage />
+                          </FormItem>
+                        )}
+                      />
+
+                      <FormField
+                        control={form.control}
+                        name="httpChallenge.port"
+                        render={({ field }) => (
+                          <FormItem>
+                            <FormLabel>{t("domain.application.form.challenge.port.label")}</FormLabel>
+                            <FormControl>
+                              <Input placeholder="80" {...field} value={field.value ?? ""} />
+                            </FormControl>
+
+                            <FormMessage />
+                          </FormItem>
+                        )}
+                      />
+                    </Show>
+
+                    <Show when={form.watch("httpChallenge.solver") == "webroot"}>
+                      <FormField
+                        control={form.control}
+                        name="httpChallenge.access"
+                        render={({ field }) => (
+                          <FormItem>
+                            <FormLabel>{t("domain.application.form.http_challenge.access.label")}</FormLabel>
+                            <Select
+                              value={field.value}
+                              onValueChange={(value) => {
+                                form.setValue("httpChallenge.access", value);
+                              }}
+                            >
+                              <SelectTrigger>
+                                <SelectValue placeholder={t("domain.application.form.http_challenge.access.placeholder")} />
+                              </SelectTrigger>
+                              <SelectContent>
+                                <SelectGroup>
+                                  <SelectLabel>{t("domain.application.form.access.list")}</SelectLabel>
+                                  {accesses
+                                    .filter((item) => webrootAccessTypes.includes(item.configType))
+                                    .map((item) => (
+                                      <SelectItem key={item.id} value={item.id}>
+                                        <div className="flex items-center space-x-2">
+                                          <img className="w-6" src={accessProvidersMap.get(item.configType)?.icon} />
+                                          <div>{item.name}</div>
+                                        </div>
+                                      </SelectItem>
+                                    ))}
+                                </SelectGroup>
+                              </SelectContent>
+                            </Select>
+                            <FormDescription>{t("domain.application.form.http_challenge.access.tips")}</FormDescription>
+
+                            <FormMessage />
+                          </FormItem>
+                        )}
+                      />
+
+                      <FormField
+                        control={form.control}
+                        name="httpChallenge.webroot"
+                        render={({ field }) => (
+                          <FormItem>
+                            <FormLabel>{t("domain.application.form.http_challenge.webroot.label")}</FormLabel>
+                            <FormControl>
+                              <Input placeholder={t("domain.application.form.http_challenge.webroot.placeholder")} {...field} value={field.value ?? ""} />
+                            </FormControl>
+
+                            <FormMessage />
+                          </FormItem>
+                        )}
+                      />
+
+                      {/* 对象存储 */}
+                      <Show when={isObjectStorageAccess(form.watch("httpChallenge.access"))}>
+                        <FormField
+                          control={form.control}
+                          name="httpChallenge.region"
+                          render={({ field }) => (
+                            <FormItem>
+                              <FormLabel>{t("domain.application.form.http_challenge.region.label")}</FormLabel>
+                              <FormControl>
+                                <Input placeholder={t("domain.application.form.http_challenge.region.placeholder")} {...field} value={field.value ?? ""} />
+                              </FormControl>
+
+                              <FormMessage />
+                            </FormItem>
+                          )}
+                        />
+
+                        <FormField
+                          control={form.control}
+                          name="httpChallenge.bucket"
+                          render={({ field }) => (
+                            <FormItem>
+                              <FormLabel>{t("domain.application.form.http_challenge.bucket.label")}</FormLabel>
+                              <FormControl>
+                                <Input placeholder={t("domain.application.form.http_challenge.bucket.placeholder")} {...field} value={field.value ?? ""} />
+                              </FormControl>
+
+                              <FormMessage />
+                            </FormItem>
+                          )}
+                        />
+                      </Show>
+                    </Show>
+                  </Show>
+
+                  {/* TLS-ALPN-01 验证 */}
+                  <Show when={form.watch("sslProvider") != "internal" && form.watch("challengeType") == "tls-alpn-01"}>
+                    <FormField
+                      control={form.control}
+                      name="tlsAlpnChallenge.access"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel>{t("domain.application.form.tls_alpn_challenge.access.label")}</FormLabel>
+                          <Select
+                            value={field.value || "local"}
+                            onValueChange={(value) => {
+                              form.setValue("tlsAlpnChallenge.access", value == "local" ? "" : value);
                             }}
                           >
                             <SelectTrigger>
-                              <SelectValue placeholder={t("domain.application.form.access.placeholder")} />
+                              <SelectValue />
                             </SelectTrigger>
                             <SelectContent>
                               <SelectGroup>
-                                <SelectLabel>{t("domain.application.form.access.list")}</SelectLabel>
+                                <SelectItem value="local">{t("domain.application.form.tls_alpn_challenge.access.local")}</SelectItem>
                                 {accesses
-                                  .filter((item) => item.usage != "deploy")
+                                  .filter((item) => item.configType == "ssh")
                                   .map((item) => (
                                     <SelectItem key={item.id} value={item.id}>
                                       <div className="flex items-center space-x-2">
@@ -343,12 +650,97 @@ const Edit = () => {
                               </SelectGroup>
                             </SelectContent>
                           </Select>
-                        </FormControl>
+                          <FormDescription>{t("domain.application.form.tls_alpn_challenge.access.tips")}</FormDescription>
 
-                        <FormMessage />
-                      </FormItem>
-                    )}
-                  />
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+
+                    <FormField
+                      control={form.control}
+                      name="tlsAlpnChallenge.interface"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel>{t("domain.application.form.challenge.interface.label")}</FormLabel>
+                          <FormControl>
+                            <Input placeholder={t("domain.application.form.challenge.interface.placeholder")} {...field} value={field.value ?? ""} />
+                          </FormControl>
+
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+
+                    <FormField
+                      control={form.control}
+                      name="tlsAlpnChallenge.port"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel>{t("domain.application.form.challenge.port.label")}</FormLabel>
+                          <FormControl>
+                            <Input placeholder="443" {...field} value={field.value ?? ""} />
+                          </FormControl>
+
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+                  </Show>
+
+                  {/* DNS 服务商授权 */}
+                  <Show when={form.watch("sslProvider") != "internal" && form.watch("challengeType") == "dns-01"}>
+                    <FormField
+                      control={form.control}
+                      name="access"
+                      render={({ field }) => (
+                        <FormItem>
+                          <FormLabel className="flex justify-between w-full">
+                            <div>{t("domain.application.form.access.label")}</div>
+                            <AccessEditDialog
+                              trigger={
+                                <div className="flex items-center font-normal cursor-pointer text-primary hover:underline">
+                                  <Plus size={14} />
+                                  {t("common.add")}
+                                </div>
+                              }
+                              op="add"
+                            />
+                          </FormLabel>
+                          <FormControl>
+                            <Select
+                              {...field}
+                              value={field.value}
+                              onValueChange={(value) => {
+                                form.setValue("access", value);
+                              }}
+                            >
+                              <SelectTrigger>
+                                <SelectValue placeholder={t("domain.application.form.access.placeholder")} />
+                              </SelectTrigger>
+                              <SelectContent>
+                                <SelectGroup>
+                                  <SelectLabel>{t("domain.application.form.access.list")}</SelectLabel>
+                                  {accesses
+                                    .filter((item) => item.usage != "deploy")
+                                    .map((item) => (
+                                      <SelectItem key={item.id} value={item.id}>
+                                        <div className="flex items-center space-x-2">
+                                          <img className="w-6" src={accessProvidersMap.get(item.configType)?.icon} />
+                                          <div>{item.name}</div>
+                                        </div>
+                                      </SelectItem>
+                                    ))}
+                                </SelectGroup>
+                              </SelectContent>
+                            </Select>
+                          </FormControl>
+
+                          <FormMessage />
+                        </FormItem>
+                      )}
+                    />
+                  </Show>
 
                   <div>
                     <hr />
@@ -361,38 +753,86 @@ const Edit = () => {
                       </CollapsibleTrigger>
                       <CollapsibleContent>
                         <div className="flex flex-col space-y-8">
-                          {/* 证书算法 */}
+                          {/* 私钥模式 */}
                           <FormField
                             control={form.control}
-                            name="keyAlgorithm"
+                            name="keyMode"
                             render={({ field }) => (
                               <FormItem>
-                                <FormLabel>{t("domain.application.form.key_algorithm.label")}</FormLabel>
+                                <FormLabel>{t("domain.application.form.key_mode.label")}</FormLabel>
                                 <Select
-                                  {...field}
                                   value={field.value}
                                   onValueChange={(value) => {
-                                    form.setValue("keyAlgorithm", value);
+                                    form.setValue("keyMode", value);
                                   }}
                                 >
                                   <SelectTrigger>
-                                    <SelectValue placeholder={t("domain.application.form.key_algorithm.placeholder")} />
+                                    <SelectValue />
                                   </SelectTrigger>
                                   <SelectContent>
                                     <SelectGroup>
-                                      <SelectItem value="RSA2048">RSA2048</SelectItem>
-                                      <SelectItem value="RSA3072">RSA3072</SelectItem>
-                                      <SelectItem value="RSA4096">RSA4096</SelectItem>
-                                      <SelectItem value="RSA8192">RSA8192</SelectItem>
-                                      <SelectItem value="EC256">EC256</SelectItem>
-                                      <SelectItem value="EC384">EC384</SelectItem>
+                                      <SelectItem value="generate">{t("domain.application.form.key_mode.option.generate")}</SelectItem>
+                                      <SelectItem value="reuse">{t("domain.application.form.key_mode.option.reuse")}</SelectItem>
+                                      <SelectItem value="csr">{t("domain.application.form.key_mode.option.csr")}</SelectItem>
                                     </SelectGroup>
                                   </SelectContent>
                                 </Select>
+                                <FormDescription>{t(`domain.application.form.key_mode.tips.${field.value || "generate"}`)}</FormDescription>
                               </FormItem>
                             )}
                           />
 
+                          {/* 证书算法，CSR 模式下由 CSR 决定 */}
+                          <Show
+                            when={form.watch("keyMode") != "csr"}
+                            fallback={
+                              <FormField
+                                control={form.control}
+                                name="csr"
+                                render={({ field }) => (
+                                  <FormItem>
+                                    <FormLabel>{t("domain.application.form.csr.label")}</FormLabel>
+                                    <FormControl>
+                                      <Textarea className="font-mono text-xs" rows={8} placeholder={t("domain.application.form.csr.placeholder")} {...field} />
+                                    </FormControl>
+                                    <FormMessage />
+                                  </FormItem>
+                                )}
+                              />
+                            }
+                          >
+                            <FormField
+                              control={form.control}
+                              name="keyAlgorithm"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.key_algorithm.label")}</FormLabel>
+                                  <Select
+                                    {...field}
+                                    value={field.value}
+                                    onValueChange={(value) => {
+                                      form.setValue("keyAlgorithm", value);
+                                    }}
+                                  >
+                                    <SelectTrigger>
+                                      <SelectValue placeholder={t("domain.application.form.key_algorithm.placeholder")} />
+                                    </SelectTrigger>
+                                    <SelectContent>
+                                      <SelectGroup>
+                                        <SelectItem value="RSA2048">RSA2048</SelectItem>
+                                        <SelectItem value="RSA3072">RSA3072</SelectItem>
+                                        <SelectItem value="RSA4096">RSA4096</SelectItem>
+                                        <SelectItem value="RSA8192">RSA8192</SelectItem>
+                                        <SelectItem value="EC256">EC256</SelectItem>
+                                        <SelectItem value="EC384">EC384</SelectItem>
+                                      </SelectGroup>
+                                    </SelectContent>
+                                  </Select>
+                                </FormItem>
+                              )}
+                            />
+                          </Show>
+
                           {/* DNS */}
                           <FormField
                             control={form.control}
@@ -478,6 +918,269 @@ const Edit = () => {
                               </FormItem>
                             )}
                           />
+
+                          {/* CA */}
+                          <FormField
+                            control={form.control}
+                            name="sslProvider"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.ssl_provider.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.ssl_provider.tips")}</FormDescription>
+                                <Select
+                                  value={field.value || "default"}
+                                  onValueChange={(value) => {
+                                    form.setValue("sslProvider", value == "default" ? "" : value);
+                                  }}
+                                >
+                                  <SelectTrigger>
+                                    <SelectValue />
+                                  </SelectTrigger>
+                                  <SelectContent>
+                                    <SelectGroup>
+                                      <SelectItem value="default">{t("domain.application.form.ssl_provider.default")}</SelectItem>
+                                      <SelectItem value="letsencrypt">Let's Encrypt</SelectItem>
+                                      <SelectItem value="zerossl">ZeroSSL</SelectItem>
+                                      <SelectItem value="gts">Google Trust Services</SelectItem>
+                                      <SelectItem value="custom">{t("settings.ca.custom.label")}</SelectItem>
+                                      <SelectItem value="internal">{t("settings.ca.internal.label")}</SelectItem>
+                                    </SelectGroup>
+                                  </SelectContent>
+                                </Select>
+
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
+
+                          {/* 内置 CA 证书主题 */}
+                          <Show when={form.watch("sslProvider") == "internal"}>
+                            <FormField
+                              control={form.control}
+                              name="internalCA.commonName"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.common_name.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.common_name.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.organization"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.organization.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.organization.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.organizationalUnit"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.organizational_unit.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.organizational_unit.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.country"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.country.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.country.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.province"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.province.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.province.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.locality"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.locality.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.internal_ca.locality.placeholder")} {...field} value={field.value ?? ""} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+
+                            <FormField
+                              control={form.control}
+                              name="internalCA.validityDays"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.internal_ca.validity_days.label")}</FormLabel>
+                                  <FormControl>
+                                    <Input
+                                      type="number"
+                                      placeholder={t("domain.application.form.internal_ca.validity_days.placeholder")}
+                                      {...field}
+                                      value={field.value || ""}
+                                      onChange={(e) => {
+                                        form.setValue("internalCA.validityDays", e.target.value ? parseInt(e.target.value) : undefined);
+                                      }}
+                                    />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+                          </Show>
+
+                          {/* 备用 CA */}
+                          <FormField
+                            control={form.control}
+                            name="sslProviderFallbacks"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.ssl_provider_fallbacks.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.ssl_provider_fallbacks.tips")}</FormDescription>
+                                <SSLProviderFallbackList
+                                  value={field.value ?? []}
+                                  primary={form.watch("sslProvider")}
+                                  onValueChange={(value) => {
+                                    form.setValue("sslProviderFallbacks", value);
+                                  }}
+                                />
+
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
+
+                          {/* 证书配置文件 */}
+                          <FormField
+                            control={form.control}
+                            name="profile"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.profile.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.profile.tips")}</FormDescription>
+                                <FormControl>
+                                  <Input placeholder={t("domain.application.form.profile.placeholder")} {...field} />
+                                </FormControl>
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
+
+                          {/* 首选证书链 */}
+                          <Show when={form.watch("sslProvider") != "internal"}>
+                            <FormField
+                              control={form.control}
+                              name="preferredChain"
+                              render={({ field }) => (
+                                <FormItem>
+                                  <FormLabel>{t("domain.application.form.preferred_chain.label")}</FormLabel>
+                                  <FormDescription>{t("domain.application.form.preferred_chain.tips")}</FormDescription>
+                                  <FormControl>
+                                    <Input placeholder={t("domain.application.form.preferred_chain.placeholder")} {...field} />
+                                  </FormControl>
+                                  <FormMessage />
+                                </FormItem>
+                              )}
+                            />
+                          </Show>
+
+                          {/* CA 测试环境 */}
+                          <FormField
+                            control={form.control}
+                            name="staging"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.staging.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.staging.tips")}</FormDescription>
+                                <FormControl>
+                                  <div>
+                                    <Switch
+                                      checked={field.value}
+                                      onCheckedChange={(value) => {
+                                        form.setValue(field.name, value);
+                                      }}
+                                    />
+                                  </div>
+                                </FormControl>
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
+
+                          {/* 按区域指定 DNS 服务商 */}
+                          <FormField
+                            control={form.control}
+                            name="dnsProviders"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.dns_providers.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.dns_providers.tips")}</FormDescription>
+                                <DnsProviderList
+                                  value={field.value ?? []}
+                                  accesses={accesses.filter((item) => item.usage != "deploy" && item.configType != "manual")}
+                                  onValueChange={(value) => {
+                                    form.setValue("dnsProviders", value);
+                                  }}
+                                />
+
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
+
+                          {/* DNS 别名 */}
+                          <FormField
+                            control={form.control}
+                            name="dnsAliases"
+                            render={({ field }) => (
+                              <FormItem>
+                                <FormLabel>{t("domain.application.form.dns_aliases.label")}</FormLabel>
+                                <FormDescription>{t("domain.application.form.dns_aliases.tips")}</FormDescription>
+                                <DnsAliasList
+                                  value={field.value ?? []}
+                                  accesses={accesses.filter((item) => item.usage != "deploy" && item.configType != "manual")}
+                                  onValueChange={(value) => {
+                                    form.setValue("dnsAliases", value);
+                                  }}
+                                />
+
+                                <FormMessage />
+                              </FormItem>
+                            )}
+                          />
                         </div>
                       </CollapsibleContent>
                     </Collapsible>
@@ -7,6 +7,10 @@ import { Earth } from "lucide-react";
 import Show from "@/components/Show";
 import DeployProgress from "@/components/certimate/DeployProgress";
 import DeployState from "@/components/certimate/DeployState";
+import ImportCertificateDialog from "@/components/certimate/ImportCertificateDialog";
+import ManualDnsConfirmDialog from "@/components/certimate/ManualDnsConfirmDialog";
+import PreflightDialog from "@/components/certimate/PreflightDialog";
+import RevokeDialog from "@/components/certimate/RevokeDialog";
 import XPagination from "@/components/certimate/XPagination";
 import {
   AlertDialogAction,
@@ -26,13 +30,18 @@ import { Toaster } from "@/components/ui/toaster";
 import { Tooltip, TooltipTrigger } from "@/components/ui/tooltip";
 import { useToast } from "@/components/ui/use-toast";
 import { CustomFile, saveFiles2ZIP } from "@/lib/file";
-import { convertZulu2Beijing, getDate, getLeftDays } from "@/lib/time";
+import { convertZulu2Beijing, getDate, getDiffDays, getLeftDays } from "@/lib/time";
 import { Domain } from "@/domain/domain";
 import { list, remove, save, subscribeId, unsubscribeId } from "@/repository/domains";
+import { useConfigContext } from "@/providers/config";
 
 const Home = () => {
   const toast = useToast();
 
+  const {
+    config: { accesses },
+  } = useConfigContext();
+
   const navigate = useNavigate();
   const { t } = useTranslation();
 
@@ -168,7 +177,10 @@ const Home = () => {
         <Toaster />
         <div className="flex justify-between items-center">
           <div className="text-muted-foreground">{t("domain.page.title")}</div>
-          <Button onClick={handleCreateClick}>{t("domain.add")}</Button>
+          <div className="flex gap-2">
+            <ImportCertificateDialog trigger={<Button variant={"outline"}>{t("domain.import")}</Button>} />
+            <Button onClick={handleCreateClick}>{t("domain.add")}</Button>
+          </div>
         </div>
 
         {!domains.length ? (
@@ -212,7 +224,10 @@ const Home = () => {
                   <div>
                     {domain.expiredAt ? (
                       <>
-                        <div>{t("domain.props.expiry.date1", { date: `${getLeftDays(domain.expiredAt)}/90` })}</div>
+                        <div>{t("domain.props.expiry.date1", { date: `${getLeftDays(domain.expiredAt)}/${domain.issuedAt ? getDiffDays(domain.issuedAt, domain.expiredAt) : 90}` })}</div>
+                        <Show when={domain.untrusted ? true : false}>
+                          <div className="text-xs text-yellow-600">{t("domain.props.untrusted")}</div>
+                        </Show>
                         <div>
                           {t("domain.props.expiry.date2", {
                             date: getDate(domain.expiredAt),
@@ -280,6 +295,28 @@ const Home = () => {
                     </Button>
                   </Show>
 
+                  <Show when={domain.applyConfig && !domain.external ? true : false}>
+                    <Separator orientation="vertical" className="h-4 mx-2" />
+                    <PreflightDialog domainId={domain.id ?? ""} />
+                  </Show>
+
+                  <Show when={domain.enabled && accesses.find((access) => access.id == domain.applyConfig?.access)?.configType == "manual" ? true : false}>
+                    <Separator orientation="vertical" className="h-4 mx-2" />
+                    <ManualDnsConfirmDialog domainId={domain.id ?? ""} />
+                  </Show>
+
+                  <Show when={domain.external ? true : false}>
+                    <Separator orientation="vertical" className="h-4 mx-2" />
+                    <ImportCertificateDialog
+                      domainId={domain.id ?? ""}
+                      trigger={
+                        <Button variant={"link"} className="p-0">
+                          {t("domain.import.update")}
+                        </Button>
+                      }
+                    />
+                  </Show>
+
                   <Show when={domain.expiredAt ? true : false}>
                     <Separator orientation="vertical" className="h-4 mx-2" />
                     <Button variant={"link"} className="p-0" onClick={() => handleDownloadClick(domain)}>
@@ -287,6 +324,11 @@ const Home = () => {
                     </Button>
                   </Show>
 
+                  <Show when={domain.enabled && domain.certificate && !domain.revoked ? true : false}>
+                    <Separator orientation="vertical" className="h-4 mx-2" />
+                    <RevokeDialog domainId={domain.id ?? ""} />
+                  </Show>
+
                   {!domain.enabled && (
                     <>
                       <Separator orientation="vertical" className="h-4 mx-2" />
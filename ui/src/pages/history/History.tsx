@@ -155,6 +155,25 @@ const History = () => {
                           })}
                         </>
                       )}
+                      {deployment.log.revoke && (
+                        <>
+                          {deployment.log.revoke.map((item: Log) => {
+                            return (
+                              <div className="flex flex-col mt-2">
+                                <div className="flex">
+                                  <div>[{item.time}]</div>
+                                  <div className="ml-2">{item.message}</div>
+                                </div>
+                                {item.info &&
+                                  item.info.map((info: string) => {
+                                    return <div className="mt-1 text-green-600 break-words">{info}</div>;
+                                  })}
+                                {item.error && <div className="mt-1 text-red-600">{item.error}</div>}
+                              </div>
+                            );
+                          })}
+                        </>
+                      )}
                     </div>
                   </SheetContent>
                 </Sheet>
@@ -0,0 +1,213 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+import Show from "@/components/Show";
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+  AlertDialogTrigger,
+} from "@/components/ui/alert-dialog";
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle, DialogTrigger } from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Separator } from "@/components/ui/separator";
+import { useToast } from "@/components/ui/use-toast";
+import { getErrMessage } from "@/lib/error";
+import { convertZulu2Beijing } from "@/lib/time";
+import { deactivate, keyChange, list, updateContact, type AcmeAccount } from "@/api/acmeAccounts";
+
+const AcmeAccounts = () => {
+  const { t } = useTranslation();
+  const { toast } = useToast();
+
+  const [accounts, setAccounts] = useState<AcmeAccount[]>([]);
+
+  const fetchData = async () => {
+    try {
+      setAccounts(await list());
+    } catch (e) {
+      toast({
+        title: t("settings.acme_accounts.list.failed.message"),
+        description: getErrMessage(e),
+        variant: "destructive",
+      });
+    }
+  };
+
+  useEffect(() => {
+    fetchData();
+  }, []);
+
+  const getCaName = (ca: string) => {
+    switch (ca) {
+      case "letsencrypt":
+        return "Let's Encrypt";
+      case "zerossl":
+        return "ZeroSSL";
+      case "gts":
+        return "Google Trust Services";
+      default:
+        // 自定义 CA 记录的是目录地址
+        return ca;
+    }
+  };
+
+  const handleAction = async (action: () => Promise<unknown>, succeeded: string) => {
+    try {
+      await action();
+      toast({
+        title: t("common.update.succeeded.message"),
+        description: succeeded,
+      });
+    } catch (e) {
+      toast({
+        title: t("common.update.failed.message"),
+        description: getErrMessage(e),
+        variant: "destructive",
+      });
+    }
+
+    fetchData();
+  };
+
+  return (
+    <div className="w-full dark:text-stone-200">
+      <div className="text-muted-foreground text-sm">{t("settings.acme_accounts.tips")}</div>
+
+      <Show when={accounts.length > 0} fallback={<div className="text-muted-foreground text-sm mt-5">{t("settings.acme_accounts.empty")}</div>}>
+        <div className="hidden sm:flex sm:flex-row text-muted-foreground text-sm border-b dark:border-stone-500 sm:p-2 mt-5">
+          <div className="w-48">{t("settings.acme_accounts.props.ca")}</div>
+          <div className="w-48">{t("settings.acme_accounts.props.email")}</div>
+          <div className="w-24">{t("settings.acme_accounts.props.status")}</div>
+          <div className="grow">{t("common.text.operations")}</div>
+        </div>
+
+        {accounts.map((account) => (
+          <div key={account.id} className="flex flex-col sm:flex-row text-secondary-foreground border-b dark:border-stone-500 sm:p-2 hover:bg-muted/50 text-sm">
+            <div className="sm:w-48 w-full pt-1 sm:pt-0 flex flex-col justify-center break-all">
+              <div>{getCaName(account.ca)}</div>
+              <div className="text-muted-foreground text-xs">{convertZulu2Beijing(account.created)}</div>
+            </div>
+            <div className="sm:w-48 w-full pt-1 sm:pt-0 flex items-center break-all">{account.email}</div>
+            <div className="sm:w-24 w-full pt-1 sm:pt-0 flex items-center">{t(`settings.acme_accounts.status.${account.status}`)}</div>
+            <div className="flex items-center grow justify-start pt-1 sm:pt-0">
+              <Show when={account.status == "valid"} fallback={"---"}>
+                <AcmeAccountContactDialog
+                  account={account}
+                  onSubmit={(email) => handleAction(() => updateContact(account.id, email), t("settings.acme_accounts.contact.succeeded.message"))}
+                />
+
+                <Separator orientation="vertical" className="h-4 mx-2" />
+                <AlertDialog>
+                  <AlertDialogTrigger asChild>
+                    <Button variant={"link"} className="p-0">
+                      {t("settings.acme_accounts.key_change")}
+                    </Button>
+                  </AlertDialogTrigger>
+                  <AlertDialogContent>
+                    <AlertDialogHeader>
+                      <AlertDialogTitle>{t("settings.acme_accounts.key_change")}</AlertDialogTitle>
+                      <AlertDialogDescription>{t("settings.acme_accounts.key_change.confirm")}</AlertDialogDescription>
+                    </AlertDialogHeader>
+                    <AlertDialogFooter>
+                      <AlertDialogCancel>{t("common.cancel")}</AlertDialogCancel>
+                      <AlertDialogAction
+                        onClick={() => {
+                          handleAction(() => keyChange(account.id), t("settings.acme_accounts.key_change.succeeded.message"));
+                        }}
+                      >
+                        {t("common.confirm")}
+                      </AlertDialogAction>
+                    </AlertDialogFooter>
+                  </AlertDialogContent>
+                </AlertDialog>
+
+                <Separator orientation="vertical" className="h-4 mx-2" />
+                <AlertDialog>
+                  <AlertDialogTrigger asChild>
+                    <Button variant={"link"} className="p-0">
+                      {t("settings.acme_accounts.deactivate")}
+                    </Button>
+                  </AlertDialogTrigger>
+                  <AlertDialogContent>
+                    <AlertDialogHeader>
+                      <AlertDialogTitle>{t("settings.acme_accounts.deactivate")}</AlertDialogTitle>
+                      <AlertDialogDescription>{t("settings.acme_accounts.deactivate.confirm")}</AlertDialogDescription>
+                    </AlertDialogHeader>
+                    <AlertDialogFooter>
+                      <AlertDialogCancel>{t("common.cancel")}</AlertDialogCancel>
+                      <AlertDialogAction
+                        onClick={() => {
+                          handleAction(() => deactivate(account.id), t("settings.acme_accounts.deactivate.succeeded.message"));
+                        }}
+                      >
+                        {t("common.confirm")}
+                      </AlertDialogAction>
+                    </AlertDialogFooter>
+                  </AlertDialogContent>
+                </AlertDialog>
+              </Show>
+            </div>
+          </div>
+        ))}
+      </Show>
+    </div>
+  );
+};
+
+type AcmeAccountContactDialogProps = {
+  account: AcmeAccount;
+  onSubmit: (email: string) => Promise<void>;
+};
+
+const AcmeAccountContactDialog = ({ account, onSubmit }: AcmeAccountContactDialogProps) => {
+  const { t } = useTranslation();
+
+  const [open, setOpen] = useState(false);
+  const [email, setEmail] = useState(account.email);
+
+  const handleOpenChange = (open: boolean) => {
+    setOpen(open);
+    if (!open) return;
+
+    setEmail(account.email);
+  };
+
+  const handleSaveClick = async () => {
+    await onSubmit(email.trim());
+    setOpen(false);
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={handleOpenChange}>
+      <DialogTrigger asChild>
+        <Button variant={"link"} className="p-0">
+          {t("settings.acme_accounts.contact")}
+        </Button>
+      </DialogTrigger>
+      <DialogContent className="sm:max-w-[500px]">
+        <DialogHeader>
+          <DialogTitle>{t("settings.acme_accounts.contact")}</DialogTitle>
+        </DialogHeader>
+
+        <div>
+          <Label>{t("settings.acme_accounts.props.email")}</Label>
+          <Input className="mt-1" type="email" value={email} onChange={(e) => setEmail(e.target.value)} />
+        </div>
+
+        <DialogFooter>
+          <Button onClick={handleSaveClick}>{t("common.save")}</Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default AcmeAccounts;
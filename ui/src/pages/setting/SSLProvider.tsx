@@ -3,17 +3,21 @@ import { useForm } from "react-hook-form";
 import { useTranslation } from "react-i18next";
 import { z } from "zod";
 import { zodResolver } from "@hookform/resolvers/zod";
+import { Server, ShieldCheck } from "lucide-react";
 
+import SSLProviderFallbackList from "@/components/certimate/SSLProviderFallbackList";
 import { Button } from "@/components/ui/button";
 import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "@/components/ui/form";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import { RadioGroup, RadioGroupItem } from "@/components/ui/radio-group";
+import { Textarea } from "@/components/ui/textarea";
 import { useToast } from "@/components/ui/use-toast";
 import { getErrMessage } from "@/lib/error";
 import { cn } from "@/lib/utils";
 import { SSLProvider as SSLProviderType, SSLProviderSetting, Setting } from "@/domain/settings";
 import { getSetting, update } from "@/repository/settings";
+import { getInternalCARootUrl } from "@/api/internalCA";
 import { produce } from "immer";
 
 type SSLProviderContext = {
@@ -99,7 +103,7 @@ const SSLProvider = () => {
         <div className="w-full md:max-w-[35em]">
           <Label className="dark:text-stone-200">{t("common.text.ca")}</Label>
           <RadioGroup
-            className="flex mt-3 dark:text-stone-200"
+            className="flex flex-wrap gap-y-2 mt-3 dark:text-stone-200"
             onValueChange={(val) => {
               setProvider(val as SSLProviderType);
             }}
@@ -133,8 +137,45 @@ const SSLProvider = () => {
                 </div>
               </Label>
             </div>
+
+            <div className="flex items-center space-x-2">
+              <RadioGroupItem value="custom" id="custom" />
+              <Label htmlFor="custom">
+                <div className={cn("flex items-center space-x-2 border p-2 rounded cursor-pointer dark:border-stone-700", getOptionCls("custom"))}>
+                  <Server className="h-6" />
+                  <div>{t("settings.ca.custom.label")}</div>
+                </div>
+              </Label>
+            </div>
+
+            <div className="flex items-center space-x-2">
+              <RadioGroupItem value="internal" id="internal" />
+              <Label htmlFor="internal">
+                <div className={cn("flex items-center space-x-2 border p-2 rounded cursor-pointer dark:border-stone-700", getOptionCls("internal"))}>
+                  <ShieldCheck className="h-6" />
+                  <div>{t("settings.ca.internal.label")}</div>
+                </div>
+              </Label>
+            </div>
           </RadioGroup>
 
+          <div className={cn("mt-5", config.content?.provider == "internal" && "hidden")}>
+            <Label className="dark:text-stone-200">{t("settings.ca.fallbacks.label")}</Label>
+            <div className="text-muted-foreground text-sm mt-1 mb-3">{t("settings.ca.fallbacks.tips")}</div>
+            <SSLProviderFallbackList
+              value={config.content?.fallbacks ?? []}
+              primary={config.content?.provider}
+              onValueChange={(value) => {
+                setConfig(
+                  produce(config, (draft) => {
+                    draft.content ??= { provider: "letsencrypt", config: {} };
+                    draft.content.fallbacks = value as SSLProviderType[];
+                  })
+                );
+              }}
+            />
+          </div>
+
           <SSLProviderForm kind={config.content?.provider ?? ""} />
         </div>
       </Context.Provider>
@@ -149,6 +190,10 @@ const SSLProviderForm = ({ kind }: { kind: string }) => {
         return <SSLProviderZeroSSLForm />;
       case "gts":
         return <SSLProviderGtsForm />;
+      case "custom":
+        return <SSLProviderCustomForm />;
+      case "internal":
+        return <SSLProviderInternalForm />;
       default:
         return <SSLProviderLetsEncryptForm />;
     }
@@ -442,5 +487,212 @@ const SSLProviderGtsForm = () => {
   );
 };
 
+const SSLProviderCustomForm = () => {
+  const { t } = useTranslation();
+
+  const { setting, onSubmit } = useSSLProviderContext();
+
+  const formSchema = z
+    .object({
+      kind: z.literal("custom"),
+      url: z.string().url({ message: t("settings.ca.custom.url.errmsg.invalid") }),
+      stagingUrl: z.union([z.literal(""), z.string().url({ message: t("settings.ca.custom.url.errmsg.invalid") })]),
+      eabKid: z.string(),
+      eabHmacKey: z.string(),
+      caCertificates: z.string(),
+    })
+    .refine((data) => !!data.eabKid == !!data.eabHmacKey, {
+      message: t("settings.ca.eab_kid_hmac_key.errmsg.empty"),
+      path: ["eabHmacKey"],
+    });
+
+  const form = useForm<z.infer<typeof formSchema>>({
+    resolver: zodResolver(formSchema),
+    defaultValues: {
+      kind: "custom",
+      url: "",
+      stagingUrl: "",
+      eabKid: "",
+      eabHmacKey: "",
+      caCertificates: "",
+    },
+  });
+
+  useEffect(() => {
+    if (setting.content) {
+      const content = setting.content;
+
+      form.reset({
+        kind: "custom",
+        url: getConfigStr(content, "custom", "url"),
+        stagingUrl: getConfigStr(content, "custom", "stagingUrl"),
+        eabKid: getConfigStr(content, "custom", "eabKid"),
+        eabHmacKey: getConfigStr(content, "custom", "eabHmacKey"),
+        caCertificates: getConfigStr(content, "custom", "caCertificates"),
+      });
+    }
+  }, [setting]);
+
+  const onLocalSubmit = async (data: z.infer<typeof formSchema>) => {
+    const newData = produce(setting, (draft) => {
+      if (!draft.content) {
+        draft.content = {
+          provider: "custom",
+          config: {
+            custom: {},
+          },
+        };
+      }
+
+      draft.content.config ??= {};
+      draft.content.config.custom = {
+        url: data.url,
+        stagingUrl: data.stagingUrl,
+        eabKid: data.eabKid,
+        eabHmacKey: data.eabHmacKey,
+        caCertificates: data.caCertificates,
+      };
+    });
+    onSubmit(newData);
+  };
+
+  return (
+    <Form {...form}>
+      <form onSubmit={form.handleSubmit(onLocalSubmit)} className="space-y-8 dark:text-stone-200">
+        <FormField
+          control={form.control}
+          name="kind"
+          render={({ field }) => (
+            <FormItem hidden>
+              <FormLabel>kind</FormLabel>
+              <FormControl>
+                <Input {...field} type="text" />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="url"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t("settings.ca.custom.url.label")}</FormLabel>
+              <FormControl>
+                <Input placeholder={t("settings.ca.custom.url.placeholder")} {...field} type="text" />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="stagingUrl"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t("settings.ca.custom.staging_url.label")}</FormLabel>
+              <FormControl>
+                <Input placeholder={t("settings.ca.custom.staging_url.placeholder")} {...field} type="text" />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="eabKid"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>EAB_KID</FormLabel>
+              <FormControl>
+                <Input placeholder={t("settings.ca.custom.eab.placeholder")} {...field} type="text" />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="eabHmacKey"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>EAB_HMAC_KEY</FormLabel>
+              <FormControl>
+                <Input placeholder={t("settings.ca.custom.eab.placeholder")} {...field} type="text" />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="caCertificates"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t("settings.ca.custom.ca_certificates.label")}</FormLabel>
+              <FormControl>
+                <Textarea className="font-mono text-xs" rows={6} placeholder={t("settings.ca.custom.ca_certificates.placeholder")} {...field} />
+              </FormControl>
+
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormMessage />
+
+        <div className="flex justify-end">
+          <Button type="submit">{t("common.update")}</Button>
+        </div>
+      </form>
+    </Form>
+  );
+};
+
+const SSLProviderInternalForm = () => {
+  const { t } = useTranslation();
+
+  const { setting, onSubmit } = useSSLProviderContext();
+
+  const onLocalSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+
+    const newData = produce(setting, (draft) => {
+      if (!draft.content) {
+        draft.content = {
+          provider: "internal",
+          config: {},
+        };
+      }
+    });
+    onSubmit(newData);
+  };
+
+  return (
+    <form onSubmit={onLocalSubmit} className="space-y-8 dark:text-stone-200">
+      <div>
+        <div className="text-muted-foreground text-sm">{t("settings.ca.internal.tips")}</div>
+        <a className="inline-block text-sm text-primary hover:underline mt-2" href={getInternalCARootUrl()} target="_blank">
+          {t("settings.ca.internal.download_root")}
+        </a>
+      </div>
+
+      <div className="flex justify-end">
+        <Button type="submit">{t("common.update")}</Button>
+      </div>
+    </form>
+  );
+};
+
 export default SSLProvider;
 
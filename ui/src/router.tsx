@@ -13,6 +13,7 @@ import Dashboard from "./pages/dashboard/Dashboard";
 import Account from "./pages/setting/Account";
 import Notify from "./pages/setting/Notify";
 import SSLProvider from "./pages/setting/SSLProvider";
+import AcmeAccounts from "./pages/setting/AcmeAccounts";
 
 export const router = createHashRouter([
   {
@@ -59,6 +60,10 @@ export const router = createHashRouter([
             path: "/setting/ssl-provider",
             element: <SSLProvider />,
           },
+          {
+            path: "/setting/acme-accounts",
+            element: <AcmeAccounts />,
+          },
         ],
       },
     ],